@@ -298,7 +298,7 @@ func (c *Client) ListChangeSummaries(
 	}
 	var summaries []*types.ChangeSummary
 	for _, c := range changes {
-		if err := newDoc.ApplyChanges(c); err != nil {
+		if err := newDoc.ApplyChanges(ctx, c); err != nil {
 			return nil, err
 		}
 
@@ -47,4 +47,12 @@ var (
 	// ErrUnsupportedCounterType is returned when the given counter type is not
 	// supported yet.
 	ErrUnsupportedCounterType = errors.New("unsupported counter type")
+
+	// ErrInvalidNodeID is returned when the given bytes can't be decoded to
+	// a node ID.
+	ErrInvalidNodeID = errors.New("invalid node ID")
+
+	// ErrMalformedOperation is returned when the given bytes can't be
+	// decoded to an operation.
+	ErrMalformedOperation = errors.New("malformed operation")
 )
@@ -17,6 +17,10 @@
 package converter_test
 
 import (
+	"bytes"
+	"context"
+	gojson "encoding/json"
+	"fmt"
 	"math"
 	"testing"
 	gotime "time"
@@ -27,11 +31,80 @@ import (
 	"github.com/yorkie-team/yorkie/api/types"
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// label is a toy custom element holding a string.
+type label struct {
+	Value     string
+	createdAt *time.Ticket
+	movedAt   *time.Ticket
+	removedAt *time.Ticket
+}
+
+type encodedLabel struct {
+	Value     string
+	Lamport   int64
+	Delimiter uint32
+	Actor     string
+}
+
+func init() {
+	crdt.RegisterElementType("label", func() crdt.Element { return &label{} })
+}
+
+func (l *label) TypeTag() string { return "label" }
+
+func (l *label) Marshal() string { return `"` + l.Value + `"` }
+
+func (l *label) DeepCopy() crdt.Element {
+	clone := *l
+	return &clone
+}
+
+func (l *label) CreatedAt() *time.Ticket { return l.createdAt }
+
+func (l *label) MovedAt() *time.Ticket { return l.movedAt }
+
+func (l *label) SetMovedAt(movedAt *time.Ticket) { l.movedAt = movedAt }
+
+func (l *label) RemovedAt() *time.Ticket { return l.removedAt }
+
+func (l *label) Remove(removedAt *time.Ticket) bool {
+	if removedAt.After(l.createdAt) && (l.removedAt == nil || removedAt.After(l.removedAt)) {
+		l.removedAt = removedAt
+		return true
+	}
+	return false
+}
+
+func (l *label) MarshalBinary() ([]byte, error) {
+	return gojson.Marshal(encodedLabel{
+		Value:     l.Value,
+		Lamport:   l.createdAt.Lamport(),
+		Delimiter: l.createdAt.Delimiter(),
+		Actor:     l.createdAt.ActorIDHex(),
+	})
+}
+
+func (l *label) UnmarshalBinary(data []byte) error {
+	var encoded encodedLabel
+	if err := gojson.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	actorID, err := time.ActorIDFromHex(encoded.Actor)
+	if err != nil {
+		return err
+	}
+
+	l.Value = encoded.Value
+	l.createdAt = time.NewTicket(encoded.Lamport, encoded.Delimiter, actorID)
+	return nil
+}
+
 func TestConverter(t *testing.T) {
 	t.Run("snapshot simple test", func(t *testing.T) {
 		obj, err := converter.BytesToObject(nil)
@@ -62,6 +135,22 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, `{"k1":[{"val":"B"}]}`, obj.Marshal())
 	})
 
+	t.Run("snapshot custom element test", func(t *testing.T) {
+		root := crdt.NewObject(crdt.NewElementRHT(), time.InitialTicket)
+		createdAt := time.NewTicket(1, 1, time.InitialActorID)
+		root.Set("k1", &label{Value: "v1", createdAt: createdAt})
+
+		bytes, err := converter.ObjectToBytes(root)
+		assert.NoError(t, err)
+
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":"v1"}`, obj.Marshal())
+		decoded, ok := obj.Get("k1").(*label)
+		assert.True(t, ok)
+		assert.Equal(t, createdAt.Key(), decoded.CreatedAt().Key())
+	})
+
 	t.Run("snapshot test", func(t *testing.T) {
 		doc := document.New("d1")
 
@@ -124,6 +213,134 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, doc.Marshal(), obj.Marshal())
 	})
 
+	t.Run("snapshot compact node id test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			root.SetNewText("k1")
+			return nil
+		}))
+		for i := 0; i < 100; i++ {
+			doc.SetActor([]*time.ActorID{actorA, actorB}[i%2])
+			assert.NoError(t, doc.Update(func(root *json.Object) error {
+				text := root.GetText("k1")
+				text.Edit(text.Len()/2, text.Len()/2, "ab")
+				return nil
+			}))
+		}
+
+		snapshot, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(snapshot)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), obj.Marshal())
+
+		// the node IDs in the Protobuf format, which the snapshots encoded
+		// before the compact form have, take more than twice the space.
+		pbElem := &api.JSONElement{}
+		assert.NoError(t, pbElem.Unmarshal(snapshot))
+		pbText := pbElem.GetJsonObject().Nodes[0].Element.GetText()
+		for i, node := range doc.Root().GetText("k1").Nodes() {
+			pbNode := pbText.Nodes[i]
+			pbNode.Id = &api.TextNodeID{
+				CreatedAt: converter.ToTimeTicket(node.ID().CreatedAt()),
+				Offset:    int32(node.ID().Offset()),
+			}
+			pbNode.CompactId = nil
+			if node.InsPrevID() != nil {
+				pbNode.InsPrevId = &api.TextNodeID{
+					CreatedAt: converter.ToTimeTicket(node.InsPrevID().CreatedAt()),
+					Offset:    int32(node.InsPrevID().Offset()),
+				}
+				pbNode.CompactInsPrevId = nil
+			}
+		}
+		pbText.ActorIds = nil
+		legacy, err := pbElem.Marshal()
+		assert.NoError(t, err)
+		assert.Less(t, 2*len(snapshot), len(legacy))
+
+		obj, err = converter.BytesToObject(legacy)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), obj.Marshal())
+	})
+
+	t.Run("snapshot interned actor id test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		doc := document.New("d1")
+		for i := 0; i < 10; i++ {
+			doc.SetActor([]*time.ActorID{actorA, actorB}[i%2])
+			assert.NoError(t, doc.Update(func(root *json.Object) error {
+				if i == 0 {
+					root.SetNewText("k1")
+				}
+				root.GetText("k1").Edit(0, 0, "a", map[string]string{"b": "1"})
+				root.SetInteger(fmt.Sprintf("k%d", i+2), i)
+				return nil
+			}))
+		}
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+
+		// the tickets of an actor in the snapshot share one actor ID.
+		actorIDs := make(map[string]*time.ActorID)
+		assertInterned := func(ticket *time.Ticket) {
+			if interned, ok := actorIDs[ticket.ActorIDHex()]; ok {
+				assert.Same(t, interned, ticket.ActorID())
+			}
+			actorIDs[ticket.ActorIDHex()] = ticket.ActorID()
+		}
+		assertInterned(obj.CreatedAt())
+		for _, elem := range obj.Members() {
+			assertInterned(elem.CreatedAt())
+		}
+		for _, node := range obj.Get("k1").(*crdt.Text).Nodes() {
+			assertInterned(node.ID().CreatedAt())
+			for _, attr := range node.Value().Attrs().Nodes() {
+				assertInterned(attr.UpdatedAt())
+			}
+		}
+		assert.Len(t, actorIDs, 3)
+		assert.Same(t, time.InitialActorID, actorIDs[time.InitialActorID.String()])
+	})
+
+	t.Run("snapshot typed text attribute test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello", map[string]string{"b": "1"})
+			return nil
+		}))
+
+		attrsOf := func(obj *crdt.Object) *crdt.RHT {
+			text := obj.Get("k1").(*crdt.Text)
+			return text.Nodes()[len(text.Nodes())-1].Value().Attrs()
+		}
+		assert.NoError(t, attrsOf(doc.RootObject()).SetValue("size", 14, time.MaxTicket))
+		assert.NoError(t, attrsOf(doc.RootObject()).SetValue("ratio", 1.5, time.MaxTicket))
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+
+		attrs := attrsOf(obj)
+		assert.Equal(t, "1", attrs.GetValue("b"))
+		assert.Equal(t, int32(14), attrs.GetValue("size"))
+		assert.Equal(t, 1.5, attrs.GetValue("ratio"))
+		assert.Equal(t, doc.RootObject().Marshal(), obj.Marshal())
+	})
+
 	t.Run("change pack test", func(t *testing.T) {
 		d1 := document.New("d1")
 
@@ -184,12 +401,81 @@ func TestConverter(t *testing.T) {
 		pack.MinSyncedTicket = time.MaxTicket
 
 		d2 := document.New("d1")
-		err = d2.ApplyChangePack(pack)
+		err = d2.ApplyChangePack(context.Background(), pack)
 		assert.NoError(t, err)
 
 		assert.Equal(t, d1.Marshal(), d2.Marshal())
 	})
 
+	t.Run("set tree through change pack test", func(t *testing.T) {
+		d1 := document.New("d1")
+		assert.NoError(t, d1.Update(func(root *json.Object) error {
+			obj, err := root.SetNewTree("k1", map[string]interface{}{
+				"k1.1": map[string]interface{}{"k1.1.1": 1},
+				"k1.2": []interface{}{"a", true, nil},
+			})
+			if err != nil {
+				return err
+			}
+
+			// the following operation of the same change refers to a
+			// descendant of the subtree.
+			obj.GetObject("k1.1").SetString("k1.1.2", "b")
+			return nil
+		}))
+		assert.Len(t, d1.CreateChangePack().Changes[0].Operations(), 2)
+
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+
+		d2 := document.New("d1")
+		assert.NoError(t, d2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, `{"k1":{"k1.1":{"k1.1.1":1,"k1.1.2":"b"},"k1.2":["a",true,null]}}`, d2.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// the subtree can't have a value that isn't a JSON value.
+		assert.Error(t, d1.Update(func(root *json.Object) error {
+			_, err := root.SetNewTree("k2", map[string]interface{}{"k2.1": struct{}{}})
+			return err
+		}))
+	})
+
+	t.Run("text embed test", func(t *testing.T) {
+		d1 := document.New("d1")
+		assert.NoError(t, d1.Update(func(root *json.Object) error {
+			root.SetNewText("k1").
+				Edit(0, 0, "Hi !", nil).
+				EditEmbed(3, 3, map[string]string{"mention": "yorkie"}, map[string]string{"b": "1"}).
+				EditEmbed(4, 4, nil)
+			return nil
+		}))
+		assert.Equal(
+			t,
+			`{"k1":[{"val":"Hi "},{"attrs":{"b":"1"},"embed":{"mention":"yorkie"}},{"embed":{}},{"val":"!"}]}`,
+			d1.Marshal(),
+		)
+
+		// 01. the embeds are delivered through the operations.
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+
+		d2 := document.New("d1")
+		assert.NoError(t, d2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// 02. the embeds are kept in the snapshot.
+		bytes, err := converter.ObjectToBytes(d1.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, d1.Marshal(), obj.Marshal())
+		assert.Equal(t, 6, obj.Get("k1").(*crdt.Text).Len())
+	})
+
 	t.Run("change pack error test", func(t *testing.T) {
 		_, err := converter.FromChangePack(nil)
 		assert.ErrorIs(t, err, converter.ErrPackRequired)
@@ -198,6 +484,196 @@ func TestConverter(t *testing.T) {
 		assert.ErrorIs(t, err, converter.ErrCheckpointRequired)
 	})
 
+	t.Run("concurrent edit and style through change pack test", func(t *testing.T) {
+		ctx := context.Background()
+		packOf := func(doc *document.Document) *change.Pack {
+			pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+			assert.NoError(t, err)
+			pack, err := converter.FromChangePack(pbPack)
+			assert.NoError(t, err)
+			return pack
+		}
+
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		d1 := document.New("d1")
+		d1.SetActor(actorA)
+		d2 := document.New("d1")
+		d2.SetActor(actorB)
+
+		assert.NoError(t, d1.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "ab", nil)
+			return nil
+		}))
+		assert.NoError(t, d2.ApplyChangePack(ctx, packOf(d1)))
+
+		// The text inserted concurrently should not be styled on both sides,
+		// regardless of the order the edit and the style are applied in.
+		assert.NoError(t, d1.Update(func(root *json.Object) error {
+			root.GetText("k1").Edit(1, 1, "X", nil)
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object) error {
+			root.GetText("k1").Style(0, 2, map[string]string{"b": "1"})
+			return nil
+		}))
+		pack1, pack2 := packOf(d1), packOf(d2)
+		assert.NoError(t, d2.ApplyChangePack(ctx, pack1))
+		assert.NoError(t, d1.ApplyChangePack(ctx, pack2))
+
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.Equal(
+			t,
+			`{"k1":[{"attrs":{"b":"1"},"val":"a"},{"val":"X"},{"attrs":{"b":"1"},"val":"b"}]}`,
+			d1.Marshal(),
+		)
+	})
+
+	t.Run("remove style through change pack test", func(t *testing.T) {
+		ctx := context.Background()
+		packOf := func(doc *document.Document) *change.Pack {
+			pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+			assert.NoError(t, err)
+			pack, err := converter.FromChangePack(pbPack)
+			assert.NoError(t, err)
+			return pack
+		}
+
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		d1 := document.New("d1")
+		d1.SetActor(actorA)
+		d2 := document.New("d1")
+		d2.SetActor(actorB)
+
+		assert.NoError(t, d1.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "ab", map[string]string{"b": "1", "i": "1"})
+			return nil
+		}))
+		assert.NoError(t, d2.ApplyChangePack(ctx, packOf(d1)))
+
+		// 01. the text inserted concurrently keeps its style on both sides.
+		assert.NoError(t, d1.Update(func(root *json.Object) error {
+			root.GetText("k1").Edit(1, 1, "X", map[string]string{"b": "1"})
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object) error {
+			root.GetText("k1").RemoveStyle(0, 2, "b")
+			return nil
+		}))
+		pack1, pack2 := packOf(d1), packOf(d2)
+		assert.NoError(t, d2.ApplyChangePack(ctx, pack1))
+		assert.NoError(t, d1.ApplyChangePack(ctx, pack2))
+
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.Equal(
+			t,
+			`{"k1":[{"attrs":{"i":"1"},"val":"a"},{"attrs":{"b":"1"},"val":"X"},{"attrs":{"i":"1"},"val":"b"}]}`,
+			d1.Marshal(),
+		)
+
+		// 02. all the styles are cleared on the other side too.
+		assert.NoError(t, d1.Update(func(root *json.Object) error {
+			root.GetText("k1").ClearAllStyles()
+			return nil
+		}))
+		assert.NoError(t, d2.ApplyChangePack(ctx, packOf(d1)))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.Equal(t, `{"k1":[{"val":"a"},{"val":"X"},{"val":"b"}]}`, d2.Marshal())
+	})
+
+	t.Run("operations stream test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			root.SetString("k1", "v1")
+			root.SetNewText("k2").Edit(0, 0, "Hello").Style(0, 2, map[string]string{"b": "1"})
+			root.SetNewCounter("k3", crdt.IntegerCnt, 0).Increase(5)
+			root.Delete("k1")
+			return nil
+		}))
+		ops := doc.CreateChangePack().Changes[0].Operations()
+
+		// 01. a mixed batch is decoded to the same operations.
+		var buf bytes.Buffer
+		assert.NoError(t, converter.EncodeOperations(&buf, ops))
+		decoded, err := converter.DecodeOperations(&buf)
+		assert.NoError(t, err)
+		assert.Len(t, decoded, len(ops))
+
+		expected, err := converter.ToOperations(ops)
+		assert.NoError(t, err)
+		actual, err := converter.ToOperations(decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		// 02. an empty stream has no operations.
+		decoded, err = converter.DecodeOperations(&bytes.Buffer{})
+		assert.NoError(t, err)
+		assert.Empty(t, decoded)
+	})
+
+	t.Run("malformed operations stream test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello")
+			return nil
+		}))
+		var buf bytes.Buffer
+		assert.NoError(t, converter.EncodeOperations(&buf, doc.CreateChangePack().Changes[0].Operations()))
+		encoded := buf.Bytes()
+
+		// 01. a truncated stream.
+		_, err := converter.DecodeOperations(bytes.NewReader(encoded[:len(encoded)-1]))
+		assert.ErrorIs(t, err, converter.ErrMalformedOperation)
+
+		// 02. a length beyond the limit and bytes that are not an operation.
+		_, err = converter.DecodeOperations(bytes.NewReader([]byte{0xff, 0xff, 0xff, 0xff, 0x7f}))
+		assert.ErrorIs(t, err, converter.ErrMalformedOperation)
+		_, err = converter.DecodeOperations(bytes.NewReader([]byte{0x03, 0xff, 0xff, 0xff}))
+		assert.ErrorIs(t, err, converter.ErrMalformedOperation)
+
+		// 03. an operation missing its fields.
+		pbOp := &api.Operation{Body: &api.Operation_Edit_{Edit: &api.Operation_Edit{}}}
+		body, err := pbOp.Marshal()
+		assert.NoError(t, err)
+		_, err = converter.DecodeOperations(bytes.NewReader(append([]byte{byte(len(body))}, body...)))
+		assert.ErrorIs(t, err, converter.ErrMalformedOperation)
+
+		pbOp = &api.Operation{Body: &api.Operation_Set_{Set: &api.Operation_Set{
+			Value: &api.JSONElementSimple{Type: api.ValueType_VALUE_TYPE_INTEGER},
+		}}}
+		body, err = pbOp.Marshal()
+		assert.NoError(t, err)
+		_, err = converter.DecodeOperations(bytes.NewReader(append([]byte{byte(len(body))}, body...)))
+		assert.ErrorIs(t, err, converter.ErrMalformedOperation)
+
+		// 04. values whose encodings are shorter than their types.
+		ticket := converter.ToTimeTicket(time.InitialTicket)
+		for _, pbOp := range []*api.Operation{
+			{Body: &api.Operation_Set_{Set: &api.Operation_Set{
+				ParentCreatedAt: ticket,
+				Value:           &api.JSONElementSimple{Type: api.ValueType_VALUE_TYPE_LONG, Value: []byte{1}, CreatedAt: ticket},
+				ExecutedAt:      ticket,
+			}}},
+			{Body: &api.Operation_Increase_{Increase: &api.Operation_Increase{
+				ParentCreatedAt: ticket,
+				Value:           &api.JSONElementSimple{Type: api.ValueType_VALUE_TYPE_INTEGER_CNT, CreatedAt: ticket},
+				ExecutedAt:      ticket,
+			}}},
+		} {
+			body, err = pbOp.Marshal()
+			assert.NoError(t, err)
+			_, err = converter.DecodeOperations(bytes.NewReader(append([]byte{byte(len(body))}, body...)))
+			assert.ErrorIs(t, err, converter.ErrMalformedOperation)
+		}
+	})
+
 	t.Run("client test", func(t *testing.T) {
 		cli := types.Client{
 			ID: time.InitialActorID,
@@ -17,15 +17,25 @@
 package converter
 
 import (
+	"bufio"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/gogo/protobuf/proto"
 
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// maxEncodedOperationLen is the maximum length of an encoded operation read
+// by DecodeOperations, so that a corrupted length doesn't allocate a huge
+// buffer.
+const maxEncodedOperationLen = 64 << 20
+
 // BytesToObject creates an Object from the given byte array.
 func BytesToObject(snapshot []byte) (*crdt.Object, error) {
 	if snapshot == nil {
@@ -37,7 +47,9 @@ func BytesToObject(snapshot []byte) (*crdt.Object, error) {
 		return nil, fmt.Errorf("unmarshal element: %w", err)
 	}
 
-	obj, err := fromJSONObject(pbElem.GetJsonObject())
+	// NOTE: The tickets of the snapshot are created with one table, so that
+	// the tickets of an actor share one ActorID.
+	obj, err := fromJSONObject(pbElem.GetJsonObject(), time.NewActorIDTable())
 	if err != nil {
 		return nil, err
 	}
@@ -45,44 +57,86 @@ func BytesToObject(snapshot []byte) (*crdt.Object, error) {
 	return obj, nil
 }
 
-func fromJSONElement(pbElem *api.JSONElement) (crdt.Element, error) {
+// DecodeOperations reads the operations written by EncodeOperations from the
+// given reader until EOF. It returns an error wrapping ErrMalformedOperation
+// if the stream is truncated or an operation can't be decoded.
+func DecodeOperations(r io.Reader) ([]operations.Operation, error) {
+	var ops []operations.Operation
+	reader := bufio.NewReader(r)
+	for {
+		length, err := binary.ReadUvarint(reader)
+		if errors.Is(err, io.EOF) {
+			return ops, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read length of operation %d: %v: %w", len(ops), err, ErrMalformedOperation)
+		}
+		if length > maxEncodedOperationLen {
+			return nil, fmt.Errorf("length %d of operation %d: %w", length, len(ops), ErrMalformedOperation)
+		}
+
+		bytes := make([]byte, length)
+		if _, err := io.ReadFull(reader, bytes); err != nil {
+			return nil, fmt.Errorf("read operation %d: %v: %w", len(ops), err, ErrMalformedOperation)
+		}
+
+		pbOp := &api.Operation{}
+		if err := pbOp.Unmarshal(bytes); err != nil {
+			return nil, fmt.Errorf("unmarshal operation %d: %v: %w", len(ops), err, ErrMalformedOperation)
+		}
+
+		decoded, err := FromOperations([]*api.Operation{pbOp})
+		if err != nil {
+			return nil, fmt.Errorf("convert operation %d: %w", len(ops), err)
+		}
+		ops = append(ops, decoded...)
+	}
+}
+
+func fromJSONElement(pbElem *api.JSONElement, table *time.ActorIDTable) (crdt.Element, error) {
+	if pbElem == nil {
+		return nil, fmt.Errorf("missing element: %w", ErrUnsupportedElement)
+	}
+
 	switch decoded := pbElem.Body.(type) {
 	case *api.JSONElement_JsonObject:
-		return fromJSONObject(decoded.JsonObject)
+		return fromJSONObject(decoded.JsonObject, table)
 	case *api.JSONElement_JsonArray:
-		return fromJSONArray(decoded.JsonArray)
+		return fromJSONArray(decoded.JsonArray, table)
 	case *api.JSONElement_Primitive_:
-		return fromJSONPrimitive(decoded.Primitive)
+		return fromJSONPrimitive(decoded.Primitive, table)
 	case *api.JSONElement_Text_:
-		return fromJSONText(decoded.Text)
+		return fromJSONText(decoded.Text, table)
 	case *api.JSONElement_Counter_:
-		return fromJSONCounter(decoded.Counter)
+		return fromJSONCounter(decoded.Counter, table)
+	case *api.JSONElement_Custom_:
+		return fromJSONCustom(decoded.Custom)
 	default:
 		return nil, fmt.Errorf("%s: %w", decoded, ErrUnsupportedElement)
 	}
 }
 
-func fromJSONObject(pbObj *api.JSONElement_JSONObject) (*crdt.Object, error) {
+func fromJSONObject(pbObj *api.JSONElement_JSONObject, table *time.ActorIDTable) (*crdt.Object, error) {
 	members := crdt.NewElementRHT()
 	for _, pbNode := range pbObj.Nodes {
-		elem, err := fromJSONElement(pbNode.Element)
+		elem, err := fromJSONElement(pbNode.Element, table)
 		if err != nil {
 			return nil, err
 		}
 		members.Set(pbNode.Key, elem)
 	}
 
-	createdAt, err := fromTimeTicket(pbObj.CreatedAt)
+	createdAt, err := fromInternedTimeTicket(pbObj.CreatedAt, table)
 	if err != nil {
 		return nil, err
 	}
 
-	movedAt, err := fromTimeTicket(pbObj.MovedAt)
+	movedAt, err := fromInternedTimeTicket(pbObj.MovedAt, table)
 	if err != nil {
 		return nil, err
 	}
 
-	removedAt, err := fromTimeTicket(pbObj.RemovedAt)
+	removedAt, err := fromInternedTimeTicket(pbObj.RemovedAt, table)
 	if err != nil {
 		return nil, err
 	}
@@ -97,25 +151,25 @@ func fromJSONObject(pbObj *api.JSONElement_JSONObject) (*crdt.Object, error) {
 	return obj, nil
 }
 
-func fromJSONArray(pbArr *api.JSONElement_JSONArray) (*crdt.Array, error) {
+func fromJSONArray(pbArr *api.JSONElement_JSONArray, table *time.ActorIDTable) (*crdt.Array, error) {
 	elements := crdt.NewRGATreeList()
 	for _, pbNode := range pbArr.Nodes {
-		elem, err := fromJSONElement(pbNode.Element)
+		elem, err := fromJSONElement(pbNode.Element, table)
 		if err != nil {
 			return nil, err
 		}
 		elements.Add(elem)
 	}
 
-	createdAt, err := fromTimeTicket(pbArr.CreatedAt)
+	createdAt, err := fromInternedTimeTicket(pbArr.CreatedAt, table)
 	if err != nil {
 		return nil, err
 	}
-	movedAt, err := fromTimeTicket(pbArr.MovedAt)
+	movedAt, err := fromInternedTimeTicket(pbArr.MovedAt, table)
 	if err != nil {
 		return nil, err
 	}
-	removedAt, err := fromTimeTicket(pbArr.RemovedAt)
+	removedAt, err := fromInternedTimeTicket(pbArr.RemovedAt, table)
 	if err != nil {
 		return nil, err
 	}
@@ -131,16 +185,17 @@ func fromJSONArray(pbArr *api.JSONElement_JSONArray) (*crdt.Array, error) {
 
 func fromJSONPrimitive(
 	pbPrim *api.JSONElement_Primitive,
+	table *time.ActorIDTable,
 ) (*crdt.Primitive, error) {
-	createdAt, err := fromTimeTicket(pbPrim.CreatedAt)
+	createdAt, err := fromInternedTimeTicket(pbPrim.CreatedAt, table)
 	if err != nil {
 		return nil, err
 	}
-	movedAt, err := fromTimeTicket(pbPrim.MovedAt)
+	movedAt, err := fromInternedTimeTicket(pbPrim.MovedAt, table)
 	if err != nil {
 		return nil, err
 	}
-	removedAt, err := fromTimeTicket(pbPrim.RemovedAt)
+	removedAt, err := fromInternedTimeTicket(pbPrim.RemovedAt, table)
 	if err != nil {
 		return nil, err
 	}
@@ -160,30 +215,42 @@ func fromJSONPrimitive(
 
 func fromJSONText(
 	pbText *api.JSONElement_Text,
+	table *time.ActorIDTable,
 ) (*crdt.Text, error) {
-	createdAt, err := fromTimeTicket(pbText.CreatedAt)
+	createdAt, err := fromInternedTimeTicket(pbText.CreatedAt, table)
 	if err != nil {
 		return nil, err
 	}
-	movedAt, err := fromTimeTicket(pbText.MovedAt)
+	movedAt, err := fromInternedTimeTicket(pbText.MovedAt, table)
 	if err != nil {
 		return nil, err
 	}
-	removedAt, err := fromTimeTicket(pbText.RemovedAt)
+	removedAt, err := fromInternedTimeTicket(pbText.RemovedAt, table)
 	if err != nil {
 		return nil, err
 	}
 
+	// NOTE: The indexes of the compact node IDs are of the actor table of
+	// the text, which keeps the actor IDs interned to the given table.
+	textTable := time.NewActorIDTable()
+	for _, pbActorID := range pbText.ActorIds {
+		actorID, err := time.ActorIDFromBytes(pbActorID)
+		if err != nil {
+			return nil, err
+		}
+		textTable.Intern(table.Intern(actorID))
+	}
+
 	rgaTreeSplit := crdt.NewRGATreeSplit(crdt.InitialTextNode())
 
 	current := rgaTreeSplit.InitialHead()
 	for _, pbNode := range pbText.Nodes {
-		textNode, err := fromTextNode(pbNode)
+		textNode, err := fromTextNode(pbNode, textTable, table)
 		if err != nil {
 			return nil, err
 		}
 		current = rgaTreeSplit.InsertAfter(current, textNode)
-		insPrevID, err := fromTextNodeID(pbNode.InsPrevId)
+		insPrevID, err := fromTextNodeIDOf(pbNode.InsPrevId, pbNode.CompactInsPrevId, textTable, table)
 		if err != nil {
 			return nil, err
 		}
@@ -206,16 +273,16 @@ func fromJSONText(
 	return text, nil
 }
 
-func fromJSONCounter(pbCnt *api.JSONElement_Counter) (*crdt.Counter, error) {
-	createdAt, err := fromTimeTicket(pbCnt.CreatedAt)
+func fromJSONCounter(pbCnt *api.JSONElement_Counter, table *time.ActorIDTable) (*crdt.Counter, error) {
+	createdAt, err := fromInternedTimeTicket(pbCnt.CreatedAt, table)
 	if err != nil {
 		return nil, err
 	}
-	movedAt, err := fromTimeTicket(pbCnt.MovedAt)
+	movedAt, err := fromInternedTimeTicket(pbCnt.MovedAt, table)
 	if err != nil {
 		return nil, err
 	}
-	removedAt, err := fromTimeTicket(pbCnt.RemovedAt)
+	removedAt, err := fromInternedTimeTicket(pbCnt.RemovedAt, table)
 	if err != nil {
 		return nil, err
 	}
@@ -235,29 +302,51 @@ func fromJSONCounter(pbCnt *api.JSONElement_Counter) (*crdt.Counter, error) {
 	return counter, nil
 }
 
+// fromJSONCustom creates a custom element with the factory registered for
+// its type tag. The element restores its own tickets from the value.
+func fromJSONCustom(pbCustom *api.JSONElement_Custom) (crdt.Element, error) {
+	return crdt.DecodeCustomElement(pbCustom.Value)
+}
+
 func fromTextNode(
 	pbNode *api.TextNode,
+	textTable *time.ActorIDTable,
+	table *time.ActorIDTable,
 ) (*crdt.RGATreeSplitNode[*crdt.TextValue], error) {
-	id, err := fromTextNodeID(pbNode.Id)
+	id, err := fromTextNodeIDOf(pbNode.Id, pbNode.CompactId, textTable, table)
 	if err != nil {
 		return nil, err
 	}
+	if id == nil {
+		return nil, fmt.Errorf("missing node ID: %w", ErrInvalidNodeID)
+	}
 
 	attrs := crdt.NewRHT()
 	for key, pbAttr := range pbNode.Attributes {
-		updatedAt, err := fromTimeTicket(pbAttr.UpdatedAt)
+		updatedAt, err := fromInternedTimeTicket(pbAttr.UpdatedAt, table)
 		if err != nil {
 			return nil, err
 		}
-		attrs.Set(key, pbAttr.Value, updatedAt)
+		// NOTE: The attributes of the old snapshots don't have the value
+		// type, and they are strings.
+		valueType := crdt.String
+		if pbAttr.ValueType != api.ValueType_VALUE_TYPE_NULL {
+			if valueType, err = fromPrimitiveValueType(pbAttr.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		if err := attrs.SetWithType(key, pbAttr.Value, valueType, updatedAt); err != nil {
+			return nil, err
+		}
 	}
 
-	textNode := crdt.NewRGATreeSplitNode(
-		id,
-		crdt.NewTextValue(pbNode.Value, attrs),
-	)
+	value := crdt.NewTextValue(pbNode.Value, attrs)
+	if pbNode.Embed != nil {
+		value = crdt.NewEmbedTextValue(pbNode.Embed.Payload, attrs)
+	}
+	textNode := crdt.NewRGATreeSplitNode(id, value)
 	if pbNode.RemovedAt != nil {
-		removedAt, err := fromTimeTicket(pbNode.RemovedAt)
+		removedAt, err := fromInternedTimeTicket(pbNode.RemovedAt, table)
 		if err != nil {
 			return nil, err
 		}
@@ -266,14 +355,39 @@ func fromTextNode(
 	return textNode, nil
 }
 
+// fromTextNodeIDOf returns the node ID of the given compact form decoded with
+// the actor table of the text, or of the given Protobuf format if the compact
+// form is absent, such as in the snapshots encoded before the compact form.
+func fromTextNodeIDOf(
+	pbTextNodeID *api.TextNodeID,
+	compactID []byte,
+	textTable *time.ActorIDTable,
+	table *time.ActorIDTable,
+) (*crdt.RGATreeSplitNodeID, error) {
+	if len(compactID) == 0 {
+		return fromTextNodeID(pbTextNodeID, table)
+	}
+
+	id, n, err := DecodeNodeID(compactID, textTable)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(compactID) {
+		return nil, fmt.Errorf("%d trailing bytes: %w", len(compactID)-n, ErrInvalidNodeID)
+	}
+
+	return id, nil
+}
+
 func fromTextNodeID(
 	pbTextNodeID *api.TextNodeID,
+	table *time.ActorIDTable,
 ) (*crdt.RGATreeSplitNodeID, error) {
 	if pbTextNodeID == nil {
 		return nil, nil
 	}
 
-	createdAt, err := fromTimeTicket(pbTextNodeID.CreatedAt)
+	createdAt, err := fromInternedTimeTicket(pbTextNodeID.CreatedAt, table)
 	if err != nil {
 		return nil, err
 	}
@@ -283,3 +397,22 @@ func fromTextNodeID(
 		int(pbTextNodeID.Offset),
 	), nil
 }
+
+// fromInternedTimeTicket converts the given ticket like fromTimeTicket, but
+// creates it with the given table, so that the tickets of an actor share one
+// ActorID.
+func fromInternedTimeTicket(pbTicket *api.TimeTicket, table *time.ActorIDTable) (*time.Ticket, error) {
+	if pbTicket == nil {
+		return nil, nil
+	}
+
+	actorID, err := time.ActorIDFromBytes(pbTicket.ActorId)
+	if err != nil {
+		return nil, err
+	}
+	return table.NewTicket(
+		pbTicket.Lamport,
+		pbTicket.Delimiter,
+		actorID,
+	), nil
+}
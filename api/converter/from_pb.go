@@ -288,8 +288,12 @@ func FromOperations(pbOps []*api.Operation) ([]operations.Operation, error) {
 			op, err = fromSelect(decoded.Select)
 		case *api.Operation_Style_:
 			op, err = fromStyle(decoded.Style)
+		case *api.Operation_RemoveStyle_:
+			op, err = fromRemoveStyle(decoded.RemoveStyle)
 		case *api.Operation_Increase_:
 			op, err = fromIncrease(decoded.Increase)
+		case *api.Operation_SetTree_:
+			op, err = fromSetTree(decoded.SetTree)
 		default:
 			return nil, ErrUnsupportedOperation
 		}
@@ -303,11 +307,11 @@ func FromOperations(pbOps []*api.Operation) ([]operations.Operation, error) {
 }
 
 func fromSet(pbSet *api.Operation_Set) (*operations.Set, error) {
-	parentCreatedAt, err := fromTimeTicket(pbSet.ParentCreatedAt)
+	parentCreatedAt, err := fromRequiredTimeTicket(pbSet.ParentCreatedAt, "parent creation time")
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbSet.ExecutedAt)
+	executedAt, err := fromRequiredTimeTicket(pbSet.ExecutedAt, "execution time")
 	if err != nil {
 		return nil, err
 	}
@@ -325,7 +329,7 @@ func fromSet(pbSet *api.Operation_Set) (*operations.Set, error) {
 }
 
 func fromAdd(pbAdd *api.Operation_Add) (*operations.Add, error) {
-	parentCreatedAt, err := fromTimeTicket(pbAdd.ParentCreatedAt)
+	parentCreatedAt, err := fromRequiredTimeTicket(pbAdd.ParentCreatedAt, "parent creation time")
 	if err != nil {
 		return nil, err
 	}
@@ -337,7 +341,7 @@ func fromAdd(pbAdd *api.Operation_Add) (*operations.Add, error) {
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbAdd.ExecutedAt)
+	executedAt, err := fromRequiredTimeTicket(pbAdd.ExecutedAt, "execution time")
 	if err != nil {
 		return nil, err
 	}
@@ -350,7 +354,7 @@ func fromAdd(pbAdd *api.Operation_Add) (*operations.Add, error) {
 }
 
 func fromMove(pbMove *api.Operation_Move) (*operations.Move, error) {
-	parentCreatedAt, err := fromTimeTicket(pbMove.ParentCreatedAt)
+	parentCreatedAt, err := fromRequiredTimeTicket(pbMove.ParentCreatedAt, "parent creation time")
 	if err != nil {
 		return nil, err
 	}
@@ -358,11 +362,11 @@ func fromMove(pbMove *api.Operation_Move) (*operations.Move, error) {
 	if err != nil {
 		return nil, err
 	}
-	createdAt, err := fromTimeTicket(pbMove.CreatedAt)
+	createdAt, err := fromRequiredTimeTicket(pbMove.CreatedAt, "creation time")
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbMove.ExecutedAt)
+	executedAt, err := fromRequiredTimeTicket(pbMove.ExecutedAt, "execution time")
 	if err != nil {
 		return nil, err
 	}
@@ -375,15 +379,15 @@ func fromMove(pbMove *api.Operation_Move) (*operations.Move, error) {
 }
 
 func fromRemove(pbRemove *api.Operation_Remove) (*operations.Remove, error) {
-	parentCreatedAt, err := fromTimeTicket(pbRemove.ParentCreatedAt)
+	parentCreatedAt, err := fromRequiredTimeTicket(pbRemove.ParentCreatedAt, "parent creation time")
 	if err != nil {
 		return nil, err
 	}
-	createdAt, err := fromTimeTicket(pbRemove.CreatedAt)
+	createdAt, err := fromRequiredTimeTicket(pbRemove.CreatedAt, "creation time")
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbRemove.ExecutedAt)
+	executedAt, err := fromRequiredTimeTicket(pbRemove.ExecutedAt, "execution time")
 	if err != nil {
 		return nil, err
 	}
@@ -395,7 +399,7 @@ func fromRemove(pbRemove *api.Operation_Remove) (*operations.Remove, error) {
 }
 
 func fromSelect(pbSelect *api.Operation_Select) (*operations.Select, error) {
-	parentCreatedAt, err := fromTimeTicket(pbSelect.ParentCreatedAt)
+	parentCreatedAt, err := fromRequiredTimeTicket(pbSelect.ParentCreatedAt, "parent creation time")
 	if err != nil {
 		return nil, err
 	}
@@ -407,7 +411,7 @@ func fromSelect(pbSelect *api.Operation_Select) (*operations.Select, error) {
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbSelect.ExecutedAt)
+	executedAt, err := fromRequiredTimeTicket(pbSelect.ExecutedAt, "execution time")
 	if err != nil {
 		return nil, err
 	}
@@ -420,7 +424,7 @@ func fromSelect(pbSelect *api.Operation_Select) (*operations.Select, error) {
 }
 
 func fromEdit(pbEdit *api.Operation_Edit) (*operations.Edit, error) {
-	parentCreatedAt, err := fromTimeTicket(pbEdit.ParentCreatedAt)
+	parentCreatedAt, err := fromRequiredTimeTicket(pbEdit.ParentCreatedAt, "parent creation time")
 	if err != nil {
 		return nil, err
 	}
@@ -438,10 +442,21 @@ func fromEdit(pbEdit *api.Operation_Edit) (*operations.Edit, error) {
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbEdit.ExecutedAt)
+	executedAt, err := fromRequiredTimeTicket(pbEdit.ExecutedAt, "execution time")
 	if err != nil {
 		return nil, err
 	}
+	if pbEdit.Embed != nil {
+		return operations.NewEditEmbed(
+			parentCreatedAt,
+			from,
+			to,
+			createdAtMapByActor,
+			pbEdit.Embed.Payload,
+			pbEdit.Attributes,
+			executedAt,
+		), nil
+	}
 	return operations.NewEdit(
 		parentCreatedAt,
 		from,
@@ -454,7 +469,7 @@ func fromEdit(pbEdit *api.Operation_Edit) (*operations.Edit, error) {
 }
 
 func fromStyle(pbStyle *api.Operation_Style) (*operations.Style, error) {
-	parentCreatedAt, err := fromTimeTicket(pbStyle.ParentCreatedAt)
+	parentCreatedAt, err := fromRequiredTimeTicket(pbStyle.ParentCreatedAt, "parent creation time")
 	if err != nil {
 		return nil, err
 	}
@@ -466,7 +481,13 @@ func fromStyle(pbStyle *api.Operation_Style) (*operations.Style, error) {
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbStyle.ExecutedAt)
+	executedAt, err := fromRequiredTimeTicket(pbStyle.ExecutedAt, "execution time")
+	if err != nil {
+		return nil, err
+	}
+	createdAtMapByActor, err := fromCreatedAtMapByActor(
+		pbStyle.CreatedAtMapByActor,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -474,13 +495,47 @@ func fromStyle(pbStyle *api.Operation_Style) (*operations.Style, error) {
 		parentCreatedAt,
 		from,
 		to,
+		createdAtMapByActor,
 		pbStyle.Attributes,
 		executedAt,
 	), nil
 }
 
+func fromRemoveStyle(pbRemoveStyle *api.Operation_RemoveStyle) (*operations.RemoveStyle, error) {
+	parentCreatedAt, err := fromRequiredTimeTicket(pbRemoveStyle.ParentCreatedAt, "parent creation time")
+	if err != nil {
+		return nil, err
+	}
+	from, err := fromTextNodePos(pbRemoveStyle.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := fromTextNodePos(pbRemoveStyle.To)
+	if err != nil {
+		return nil, err
+	}
+	executedAt, err := fromRequiredTimeTicket(pbRemoveStyle.ExecutedAt, "execution time")
+	if err != nil {
+		return nil, err
+	}
+	createdAtMapByActor, err := fromCreatedAtMapByActor(
+		pbRemoveStyle.CreatedAtMapByActor,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return operations.NewRemoveStyle(
+		parentCreatedAt,
+		from,
+		to,
+		createdAtMapByActor,
+		pbRemoveStyle.AttributesToRemove,
+		executedAt,
+	), nil
+}
+
 func fromIncrease(pbInc *api.Operation_Increase) (*operations.Increase, error) {
-	parentCreatedAt, err := fromTimeTicket(pbInc.ParentCreatedAt)
+	parentCreatedAt, err := fromRequiredTimeTicket(pbInc.ParentCreatedAt, "parent creation time")
 	if err != nil {
 		return nil, err
 	}
@@ -488,7 +543,7 @@ func fromIncrease(pbInc *api.Operation_Increase) (*operations.Increase, error) {
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbInc.ExecutedAt)
+	executedAt, err := fromRequiredTimeTicket(pbInc.ExecutedAt, "execution time")
 	if err != nil {
 		return nil, err
 	}
@@ -499,6 +554,31 @@ func fromIncrease(pbInc *api.Operation_Increase) (*operations.Increase, error) {
 	), nil
 }
 
+func fromSetTree(pbSetTree *api.Operation_SetTree) (*operations.SetTree, error) {
+	parentCreatedAt, err := fromRequiredTimeTicket(pbSetTree.ParentCreatedAt, "parent creation time")
+	if err != nil {
+		return nil, err
+	}
+	executedAt, err := fromRequiredTimeTicket(pbSetTree.ExecutedAt, "execution time")
+	if err != nil {
+		return nil, err
+	}
+	if pbSetTree.Value == nil {
+		return nil, fmt.Errorf("missing value: %w", ErrMalformedOperation)
+	}
+	elem, err := fromJSONElement(pbSetTree.Value, time.NewActorIDTable())
+	if err != nil {
+		return nil, err
+	}
+
+	return operations.NewSetTree(
+		parentCreatedAt,
+		pbSetTree.Key,
+		elem,
+		executedAt,
+	), nil
+}
+
 func fromCreatedAtMapByActor(
 	pbCreatedAtMapByActor map[string]*api.TimeTicket,
 ) (map[string]*time.Ticket, error) {
@@ -516,7 +596,10 @@ func fromCreatedAtMapByActor(
 func fromTextNodePos(
 	pbPos *api.TextNodePos,
 ) (*crdt.RGATreeSplitNodePos, error) {
-	createdAt, err := fromTimeTicket(pbPos.CreatedAt)
+	if pbPos == nil {
+		return nil, fmt.Errorf("missing text node pos: %w", ErrMalformedOperation)
+	}
+	createdAt, err := fromRequiredTimeTicket(pbPos.CreatedAt, "creation time of text node pos")
 	if err != nil {
 		return nil, err
 	}
@@ -542,10 +625,41 @@ func fromTimeTicket(pbTicket *api.TimeTicket) (*time.Ticket, error) {
 	), nil
 }
 
+// fromRequiredTimeTicket converts the given ticket like fromTimeTicket, but
+// returns an error if the ticket is missing.
+func fromRequiredTimeTicket(pbTicket *api.TimeTicket, name string) (*time.Ticket, error) {
+	if pbTicket == nil {
+		return nil, fmt.Errorf("missing %s: %w", name, ErrMalformedOperation)
+	}
+
+	return fromTimeTicket(pbTicket)
+}
+
+// primitiveValueSizes are the sizes of the encodings of the fixed-size
+// primitive values, which are checked before decoding them.
+var primitiveValueSizes = map[crdt.ValueType]int{
+	crdt.Boolean: 1,
+	crdt.Integer: 4,
+	crdt.Long:    8,
+	crdt.Double:  8,
+	crdt.Date:    8,
+}
+
+// counterValueSizes are the sizes of the encodings of the counter values,
+// which are checked before decoding them.
+var counterValueSizes = map[crdt.CounterType]int{
+	crdt.IntegerCnt: 4,
+	crdt.LongCnt:    8,
+	crdt.DoubleCnt:  8,
+}
+
 func fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
+	if pbElement == nil {
+		return nil, fmt.Errorf("missing element: %w", ErrMalformedOperation)
+	}
 	switch pbType := pbElement.Type; pbType {
 	case api.ValueType_VALUE_TYPE_JSON_OBJECT:
-		createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+		createdAt, err := fromRequiredTimeTicket(pbElement.CreatedAt, "creation time")
 		if err != nil {
 			return nil, err
 		}
@@ -554,7 +668,7 @@ func fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
 			createdAt,
 		), nil
 	case api.ValueType_VALUE_TYPE_JSON_ARRAY:
-		createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+		createdAt, err := fromRequiredTimeTicket(pbElement.CreatedAt, "creation time")
 		if err != nil {
 			return nil, err
 		}
@@ -581,7 +695,10 @@ func fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
 		if err != nil {
 			return nil, err
 		}
-		createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+		if size, ok := primitiveValueSizes[valueType]; ok && len(pbElement.Value) != size {
+			return nil, fmt.Errorf("value of %d bytes for type %d: %w", len(pbElement.Value), pbType, ErrMalformedOperation)
+		}
+		createdAt, err := fromRequiredTimeTicket(pbElement.CreatedAt, "creation time")
 		if err != nil {
 			return nil, err
 		}
@@ -590,7 +707,7 @@ func fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
 			createdAt,
 		), nil
 	case api.ValueType_VALUE_TYPE_TEXT:
-		createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+		createdAt, err := fromRequiredTimeTicket(pbElement.CreatedAt, "creation time")
 		if err != nil {
 			return nil, err
 		}
@@ -605,7 +722,10 @@ func fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
 		if err != nil {
 			return nil, err
 		}
-		createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+		if size := counterValueSizes[counterType]; len(pbElement.Value) != size {
+			return nil, fmt.Errorf("value of %d bytes for type %d: %w", len(pbElement.Value), pbType, ErrMalformedOperation)
+		}
+		createdAt, err := fromRequiredTimeTicket(pbElement.CreatedAt, "creation time")
 		if err != nil {
 			return nil, err
 		}
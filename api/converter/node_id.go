@@ -0,0 +1,76 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// EncodeNodeID encodes the given node ID in a compact form: the Lamport
+// timestamp, the index of the actor in the given table, the delimiter and
+// the offset, each as a varint. The actor is interned to the table if it is
+// not in the table yet, so the actor IDs of the table should be stored with
+// the encoded node IDs to decode them.
+func EncodeNodeID(id *crdt.RGATreeSplitNodeID, table *time.ActorIDTable) []byte {
+	createdAt := id.CreatedAt()
+	table.Intern(createdAt.ActorID())
+	index, _ := table.Index(createdAt.ActorID())
+
+	var bytes []byte
+	bytes = binary.AppendVarint(bytes, createdAt.Lamport())
+	bytes = binary.AppendUvarint(bytes, uint64(index))
+	bytes = binary.AppendUvarint(bytes, uint64(createdAt.Delimiter()))
+	bytes = binary.AppendUvarint(bytes, uint64(id.Offset()))
+	return bytes
+}
+
+// DecodeNodeID decodes the node ID encoded by EncodeNodeID from the
+// beginning of the given bytes. It returns the number of bytes read, so
+// that the node IDs encoded in a row can be decoded one by one.
+func DecodeNodeID(bytes []byte, table *time.ActorIDTable) (*crdt.RGATreeSplitNodeID, int, error) {
+	read := 0
+
+	lamport, n := binary.Varint(bytes)
+	if n <= 0 {
+		return nil, 0, fmt.Errorf("decode lamport: %w", ErrInvalidNodeID)
+	}
+	read += n
+
+	var fields [3]uint64
+	for i := range fields {
+		value, n := binary.Uvarint(bytes[read:])
+		if n <= 0 {
+			return nil, 0, fmt.Errorf("decode field %d: %w", i, ErrInvalidNodeID)
+		}
+		fields[i] = value
+		read += n
+	}
+
+	actorID, ok := table.ActorID(int(fields[0]))
+	if !ok {
+		return nil, 0, fmt.Errorf("actor index %d: %w", fields[0], ErrInvalidNodeID)
+	}
+
+	return crdt.NewRGATreeSplitNodeID(
+		table.NewTicket(lamport, uint32(fields[1]), actorID),
+		int(fields[2]),
+	), read, nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestNodeID(t *testing.T) {
+	t.Run("encode and decode test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		ids := []*crdt.RGATreeSplitNodeID{
+			crdt.NewRGATreeSplitNodeID(time.NewTicket(1, 1, actorA), 0),
+			crdt.NewRGATreeSplitNodeID(time.NewTicket(300, 2, actorB), 5),
+			crdt.NewRGATreeSplitNodeID(time.NewTicket(1<<40, 70000, actorA), 1<<20),
+		}
+
+		table := time.NewActorIDTable()
+		var bytes []byte
+		for _, id := range ids {
+			bytes = append(bytes, converter.EncodeNodeID(id, table)...)
+		}
+		assert.Equal(t, 2, table.Len())
+
+		for _, id := range ids {
+			decoded, n, err := converter.DecodeNodeID(bytes, table)
+			assert.NoError(t, err)
+			assert.True(t, id.Equal(decoded))
+			assert.Equal(t, id.CreatedAt().ActorIDHex(), decoded.CreatedAt().ActorIDHex())
+			bytes = bytes[n:]
+		}
+		assert.Len(t, bytes, 0)
+	})
+
+	t.Run("decode invalid bytes test", func(t *testing.T) {
+		table := time.NewActorIDTable()
+		_, _, err := converter.DecodeNodeID(nil, table)
+		assert.ErrorIs(t, err, converter.ErrInvalidNodeID)
+
+		id := crdt.NewRGATreeSplitNodeID(time.NewTicket(1, 1, time.InitialActorID), 0)
+		bytes := converter.EncodeNodeID(id, table)
+		_, _, err = converter.DecodeNodeID(bytes[:len(bytes)-1], table)
+		assert.ErrorIs(t, err, converter.ErrInvalidNodeID)
+
+		_, _, err = converter.DecodeNodeID(bytes, time.NewActorIDTable())
+		assert.ErrorIs(t, err, converter.ErrInvalidNodeID)
+	})
+}
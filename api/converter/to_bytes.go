@@ -17,15 +17,43 @@
 package converter
 
 import (
+	"encoding/binary"
 	"fmt"
+	"io"
 	"reflect"
 
 	"github.com/gogo/protobuf/proto"
 
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// EncodeOperations writes the given operations to the given writer. Each
+// operation is written as its Protobuf encoding prefixed with the length in
+// uvarint, so that DecodeOperations can read them as a stream.
+func EncodeOperations(w io.Writer, ops []operations.Operation) error {
+	pbOps, err := ToOperations(ops)
+	if err != nil {
+		return err
+	}
+
+	for _, pbOp := range pbOps {
+		bytes, err := pbOp.Marshal()
+		if err != nil {
+			return fmt.Errorf("marshal operation: %w", err)
+		}
+
+		prefix := binary.AppendUvarint(nil, uint64(len(bytes)))
+		if _, err := w.Write(append(prefix, bytes...)); err != nil {
+			return fmt.Errorf("write operation: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // ObjectToBytes converts the given object to byte array.
 func ObjectToBytes(obj *crdt.Object) ([]byte, error) {
 	pbElem, err := toJSONElement(obj)
@@ -49,9 +77,11 @@ func toJSONElement(elem crdt.Element) (*api.JSONElement, error) {
 	case *crdt.Primitive:
 		return toPrimitive(elem)
 	case *crdt.Text:
-		return toText(elem), nil
+		return toText(elem)
 	case *crdt.Counter:
 		return toCounter(elem)
+	case crdt.CustomElement:
+		return toCustom(elem)
 	default:
 		return nil, fmt.Errorf("%v: %w", reflect.TypeOf(elem), ErrUnsupportedElement)
 	}
@@ -108,15 +138,30 @@ func toPrimitive(primitive *crdt.Primitive) (*api.JSONElement, error) {
 	}, nil
 }
 
-func toText(text *crdt.Text) *api.JSONElement {
+// toText converts the given text to Protobuf format. The IDs of the nodes
+// are encoded in the compact form with the actor table of the text, which is
+// stored once in the text.
+func toText(text *crdt.Text) (*api.JSONElement, error) {
+	table := time.NewActorIDTable()
+	pbTextNodes, err := toTextNodes(text.Nodes(), table)
+	if err != nil {
+		return nil, err
+	}
+
+	var actorIDs [][]byte
+	for _, actorID := range table.ActorIDs() {
+		actorIDs = append(actorIDs, actorID.Bytes())
+	}
+
 	return &api.JSONElement{
 		Body: &api.JSONElement_Text_{Text: &api.JSONElement_Text{
-			Nodes:     toTextNodes(text.Nodes()),
+			Nodes:     pbTextNodes,
 			CreatedAt: ToTimeTicket(text.CreatedAt()),
 			MovedAt:   ToTimeTicket(text.MovedAt()),
 			RemovedAt: ToTimeTicket(text.RemovedAt()),
+			ActorIds:  actorIDs,
 		}},
-	}
+	}, nil
 }
 
 func toCounter(counter *crdt.Counter) (*api.JSONElement, error) {
@@ -136,6 +181,19 @@ func toCounter(counter *crdt.Counter) (*api.JSONElement, error) {
 	}, nil
 }
 
+func toCustom(elem crdt.CustomElement) (*api.JSONElement, error) {
+	value, err := crdt.EncodeCustomElement(elem)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.JSONElement{
+		Body: &api.JSONElement_Custom_{Custom: &api.JSONElement_Custom{
+			Value: value,
+		}},
+	}, nil
+}
+
 func toRHTNodes(rhtNodes []*crdt.ElementRHTNode) ([]*api.RHTNode, error) {
 	var pbRHTNodes []*api.RHTNode
 	for _, rhtNode := range rhtNodes {
@@ -167,33 +225,44 @@ func toRGANodes(rgaNodes []*crdt.RGATreeListNode) ([]*api.RGANode, error) {
 	return pbRGANodes, nil
 }
 
-func toTextNodes(textNodes []*crdt.RGATreeSplitNode[*crdt.TextValue]) []*api.TextNode {
+func toTextNodes(
+	textNodes []*crdt.RGATreeSplitNode[*crdt.TextValue],
+	table *time.ActorIDTable,
+) ([]*api.TextNode, error) {
 	var pbTextNodes []*api.TextNode
 	for _, textNode := range textNodes {
 		value := textNode.Value()
 
 		attrs := make(map[string]*api.TextNodeAttr)
 		for _, node := range value.Attrs().Nodes() {
+			valueType, err := toValueType(node.ValueType())
+			if err != nil {
+				return nil, err
+			}
 			attrs[node.Key()] = &api.TextNodeAttr{
 				Value:     node.Value(),
 				UpdatedAt: ToTimeTicket(node.UpdatedAt()),
+				ValueType: valueType,
 			}
 		}
 
 		pbTextNode := &api.TextNode{
-			Id:         toTextNodeID(textNode.ID()),
+			CompactId:  EncodeNodeID(textNode.ID(), table),
 			Attributes: attrs,
 			Value:      value.Value(),
 			RemovedAt:  ToTimeTicket(textNode.RemovedAt()),
 		}
+		if value.IsEmbed() {
+			pbTextNode.Embed = toTextEmbed(value.Embed())
+		}
 
 		if textNode.InsPrevID() != nil {
-			pbTextNode.InsPrevId = toTextNodeID(textNode.InsPrevID())
+			pbTextNode.CompactInsPrevId = EncodeNodeID(textNode.InsPrevID(), table)
 		}
 
 		pbTextNodes = append(pbTextNodes, pbTextNode)
 	}
-	return pbTextNodes
+	return pbTextNodes, nil
 }
 
 func toTextNodeID(id *crdt.RGATreeSplitNodeID) *api.TextNodeID {
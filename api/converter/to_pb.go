@@ -251,8 +251,12 @@ func ToOperations(ops []operations.Operation) ([]*api.Operation, error) {
 			pbOperation.Body, err = toSelect(op)
 		case *operations.Style:
 			pbOperation.Body, err = toStyle(op)
+		case *operations.RemoveStyle:
+			pbOperation.Body, err = toRemoveStyle(op)
 		case *operations.Increase:
 			pbOperation.Body, err = toIncrease(op)
+		case *operations.SetTree:
+			pbOperation.Body, err = toSetTree(op)
 		default:
 			return nil, ErrUnsupportedOperation
 		}
@@ -361,10 +365,19 @@ func toEdit(e *operations.Edit) (*api.Operation_Edit_, error) {
 			Content:             e.Content(),
 			Attributes:          e.Attributes(),
 			ExecutedAt:          ToTimeTicket(e.ExecutedAt()),
+			Embed:               toTextEmbed(e.Embed()),
 		},
 	}, nil
 }
 
+func toTextEmbed(embed map[string]string) *api.TextEmbed {
+	if embed == nil {
+		return nil
+	}
+
+	return &api.TextEmbed{Payload: embed}
+}
+
 func toSelect(s *operations.Select) (*api.Operation_Select_, error) {
 	return &api.Operation_Select_{
 		Select: &api.Operation_Select{
@@ -379,11 +392,25 @@ func toSelect(s *operations.Select) (*api.Operation_Select_, error) {
 func toStyle(style *operations.Style) (*api.Operation_Style_, error) {
 	return &api.Operation_Style_{
 		Style: &api.Operation_Style{
-			ParentCreatedAt: ToTimeTicket(style.ParentCreatedAt()),
-			From:            toTextNodePos(style.From()),
-			To:              toTextNodePos(style.To()),
-			Attributes:      style.Attributes(),
-			ExecutedAt:      ToTimeTicket(style.ExecutedAt()),
+			ParentCreatedAt:     ToTimeTicket(style.ParentCreatedAt()),
+			From:                toTextNodePos(style.From()),
+			To:                  toTextNodePos(style.To()),
+			Attributes:          style.Attributes(),
+			ExecutedAt:          ToTimeTicket(style.ExecutedAt()),
+			CreatedAtMapByActor: toCreatedAtMapByActor(style.CreatedAtMapByActor()),
+		},
+	}, nil
+}
+
+func toRemoveStyle(removeStyle *operations.RemoveStyle) (*api.Operation_RemoveStyle_, error) {
+	return &api.Operation_RemoveStyle_{
+		RemoveStyle: &api.Operation_RemoveStyle{
+			ParentCreatedAt:     ToTimeTicket(removeStyle.ParentCreatedAt()),
+			From:                toTextNodePos(removeStyle.From()),
+			To:                  toTextNodePos(removeStyle.To()),
+			AttributesToRemove:  removeStyle.AttributesToRemove(),
+			ExecutedAt:          ToTimeTicket(removeStyle.ExecutedAt()),
+			CreatedAtMapByActor: toCreatedAtMapByActor(removeStyle.CreatedAtMapByActor()),
 		},
 	}, nil
 }
@@ -455,6 +482,22 @@ func toTextNodePos(pos *crdt.RGATreeSplitNodePos) *api.TextNodePos {
 	}
 }
 
+func toSetTree(setTree *operations.SetTree) (*api.Operation_SetTree_, error) {
+	pbElem, err := toJSONElement(setTree.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Operation_SetTree_{
+		SetTree: &api.Operation_SetTree{
+			ParentCreatedAt: ToTimeTicket(setTree.ParentCreatedAt()),
+			Key:             setTree.Key(),
+			Value:           pbElem,
+			ExecutedAt:      ToTimeTicket(setTree.ExecutedAt()),
+		},
+	}, nil
+}
+
 func toCreatedAtMapByActor(
 	createdAtMapByActor map[string]*time.Ticket,
 ) map[string]*api.TimeTicket {
@@ -336,6 +336,8 @@ type Operation struct {
 	//	*Operation_Select_
 	//	*Operation_Style_
 	//	*Operation_Increase_
+	//	*Operation_SetTree_
+	//	*Operation_RemoveStyle_
 	Body                 isOperation_Body `protobuf_oneof:"body"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
@@ -405,15 +407,23 @@ type Operation_Style_ struct {
 type Operation_Increase_ struct {
 	Increase *Operation_Increase `protobuf:"bytes,8,opt,name=increase,proto3,oneof" json:"increase,omitempty"`
 }
+type Operation_SetTree_ struct {
+	SetTree *Operation_SetTree `protobuf:"bytes,9,opt,name=set_tree,json=setTree,proto3,oneof" json:"set_tree,omitempty"`
+}
+type Operation_RemoveStyle_ struct {
+	RemoveStyle *Operation_RemoveStyle `protobuf:"bytes,10,opt,name=remove_style,json=removeStyle,proto3,oneof" json:"remove_style,omitempty"`
+}
 
-func (*Operation_Set_) isOperation_Body()      {}
-func (*Operation_Add_) isOperation_Body()      {}
-func (*Operation_Move_) isOperation_Body()     {}
-func (*Operation_Remove_) isOperation_Body()   {}
-func (*Operation_Edit_) isOperation_Body()     {}
-func (*Operation_Select_) isOperation_Body()   {}
-func (*Operation_Style_) isOperation_Body()    {}
-func (*Operation_Increase_) isOperation_Body() {}
+func (*Operation_Set_) isOperation_Body()         {}
+func (*Operation_Add_) isOperation_Body()         {}
+func (*Operation_Move_) isOperation_Body()        {}
+func (*Operation_Remove_) isOperation_Body()      {}
+func (*Operation_Edit_) isOperation_Body()        {}
+func (*Operation_Select_) isOperation_Body()      {}
+func (*Operation_Style_) isOperation_Body()       {}
+func (*Operation_Increase_) isOperation_Body()    {}
+func (*Operation_SetTree_) isOperation_Body()     {}
+func (*Operation_RemoveStyle_) isOperation_Body() {}
 
 func (m *Operation) GetBody() isOperation_Body {
 	if m != nil {
@@ -478,6 +488,20 @@ func (m *Operation) GetIncrease() *Operation_Increase {
 	return nil
 }
 
+func (m *Operation) GetSetTree() *Operation_SetTree {
+	if x, ok := m.GetBody().(*Operation_SetTree_); ok {
+		return x.SetTree
+	}
+	return nil
+}
+
+func (m *Operation) GetRemoveStyle() *Operation_RemoveStyle {
+	if x, ok := m.GetBody().(*Operation_RemoveStyle_); ok {
+		return x.RemoveStyle
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Operation) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -489,6 +513,8 @@ func (*Operation) XXX_OneofWrappers() []interface{} {
 		(*Operation_Select_)(nil),
 		(*Operation_Style_)(nil),
 		(*Operation_Increase_)(nil),
+		(*Operation_SetTree_)(nil),
+		(*Operation_RemoveStyle_)(nil),
 	}
 }
 
@@ -776,6 +802,7 @@ type Operation_Edit struct {
 	Content              string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
 	ExecutedAt           *TimeTicket            `protobuf:"bytes,6,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
 	Attributes           map[string]string      `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Embed                *TextEmbed             `protobuf:"bytes,8,opt,name=embed,proto3" json:"embed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -863,6 +890,13 @@ func (m *Operation_Edit) GetAttributes() map[string]string {
 	return nil
 }
 
+func (m *Operation_Edit) GetEmbed() *TextEmbed {
+	if m != nil {
+		return m.Embed
+	}
+	return nil
+}
+
 type Operation_Select struct {
 	ParentCreatedAt      *TimeTicket  `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
 	From                 *TextNodePos `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
@@ -935,14 +969,15 @@ func (m *Operation_Select) GetExecutedAt() *TimeTicket {
 }
 
 type Operation_Style struct {
-	ParentCreatedAt      *TimeTicket       `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
-	From                 *TextNodePos      `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To                   *TextNodePos      `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Attributes           map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	ExecutedAt           *TimeTicket       `protobuf:"bytes,5,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	ParentCreatedAt      *TimeTicket            `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	From                 *TextNodePos           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *TextNodePos           `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Attributes           map[string]string      `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExecutedAt           *TimeTicket            `protobuf:"bytes,5,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	CreatedAtMapByActor  map[string]*TimeTicket `protobuf:"bytes,6,rep,name=created_at_map_by_actor,json=createdAtMapByActor,proto3" json:"created_at_map_by_actor,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *Operation_Style) Reset()         { *m = Operation_Style{} }
@@ -1013,6 +1048,100 @@ func (m *Operation_Style) GetExecutedAt() *TimeTicket {
 	return nil
 }
 
+func (m *Operation_Style) GetCreatedAtMapByActor() map[string]*TimeTicket {
+	if m != nil {
+		return m.CreatedAtMapByActor
+	}
+	return nil
+}
+
+type Operation_RemoveStyle struct {
+	ParentCreatedAt      *TimeTicket            `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	From                 *TextNodePos           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *TextNodePos           `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	AttributesToRemove   []string               `protobuf:"bytes,4,rep,name=attributes_to_remove,json=attributesToRemove,proto3" json:"attributes_to_remove,omitempty"`
+	ExecutedAt           *TimeTicket            `protobuf:"bytes,5,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	CreatedAtMapByActor  map[string]*TimeTicket `protobuf:"bytes,6,rep,name=created_at_map_by_actor,json=createdAtMapByActor,proto3" json:"created_at_map_by_actor,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Operation_RemoveStyle) Reset()         { *m = Operation_RemoveStyle{} }
+func (m *Operation_RemoveStyle) String() string { return proto.CompactTextString(m) }
+func (*Operation_RemoveStyle) ProtoMessage()    {}
+func (*Operation_RemoveStyle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{3, 7}
+}
+func (m *Operation_RemoveStyle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_RemoveStyle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_RemoveStyle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Operation_RemoveStyle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_RemoveStyle.Merge(m, src)
+}
+func (m *Operation_RemoveStyle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_RemoveStyle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_RemoveStyle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation_RemoveStyle proto.InternalMessageInfo
+
+func (m *Operation_RemoveStyle) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
+	}
+	return nil
+}
+
+func (m *Operation_RemoveStyle) GetFrom() *TextNodePos {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *Operation_RemoveStyle) GetTo() *TextNodePos {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *Operation_RemoveStyle) GetAttributesToRemove() []string {
+	if m != nil {
+		return m.AttributesToRemove
+	}
+	return nil
+}
+
+func (m *Operation_RemoveStyle) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return nil
+}
+
+func (m *Operation_RemoveStyle) GetCreatedAtMapByActor() map[string]*TimeTicket {
+	if m != nil {
+		return m.CreatedAtMapByActor
+	}
+	return nil
+}
+
 type Operation_Increase struct {
 	ParentCreatedAt      *TimeTicket        `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
 	Value                *JSONElementSimple `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -1026,7 +1155,7 @@ func (m *Operation_Increase) Reset()         { *m = Operation_Increase{} }
 func (m *Operation_Increase) String() string { return proto.CompactTextString(m) }
 func (*Operation_Increase) ProtoMessage()    {}
 func (*Operation_Increase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{3, 7}
+	return fileDescriptor_36361b2f5d0f0896, []int{3, 8}
 }
 func (m *Operation_Increase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,6 +1205,77 @@ func (m *Operation_Increase) GetExecutedAt() *TimeTicket {
 	return nil
 }
 
+type Operation_SetTree struct {
+	ParentCreatedAt      *TimeTicket  `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	Key                  string       `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value                *JSONElement `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ExecutedAt           *TimeTicket  `protobuf:"bytes,4,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Operation_SetTree) Reset()         { *m = Operation_SetTree{} }
+func (m *Operation_SetTree) String() string { return proto.CompactTextString(m) }
+func (*Operation_SetTree) ProtoMessage()    {}
+func (*Operation_SetTree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{3, 9}
+}
+func (m *Operation_SetTree) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_SetTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_SetTree.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Operation_SetTree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_SetTree.Merge(m, src)
+}
+func (m *Operation_SetTree) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_SetTree) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_SetTree.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation_SetTree proto.InternalMessageInfo
+
+func (m *Operation_SetTree) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
+	}
+	return nil
+}
+
+func (m *Operation_SetTree) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Operation_SetTree) GetValue() *JSONElement {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Operation_SetTree) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return nil
+}
+
 type JSONElementSimple struct {
 	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	MovedAt              *TimeTicket `protobuf:"bytes,2,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
@@ -1163,6 +1363,7 @@ type JSONElement struct {
 	//	*JSONElement_Primitive_
 	//	*JSONElement_Text_
 	//	*JSONElement_Counter_
+	//	*JSONElement_Custom_
 	Body                 isJSONElement_Body `protobuf_oneof:"body"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -1223,12 +1424,16 @@ type JSONElement_Text_ struct {
 type JSONElement_Counter_ struct {
 	Counter *JSONElement_Counter `protobuf:"bytes,6,opt,name=counter,proto3,oneof" json:"counter,omitempty"`
 }
+type JSONElement_Custom_ struct {
+	Custom *JSONElement_Custom `protobuf:"bytes,7,opt,name=custom,proto3,oneof" json:"custom,omitempty"`
+}
 
 func (*JSONElement_JsonObject) isJSONElement_Body() {}
 func (*JSONElement_JsonArray) isJSONElement_Body()  {}
 func (*JSONElement_Primitive_) isJSONElement_Body() {}
 func (*JSONElement_Text_) isJSONElement_Body()      {}
 func (*JSONElement_Counter_) isJSONElement_Body()   {}
+func (*JSONElement_Custom_) isJSONElement_Body()    {}
 
 func (m *JSONElement) GetBody() isJSONElement_Body {
 	if m != nil {
@@ -1272,6 +1477,13 @@ func (m *JSONElement) GetCounter() *JSONElement_Counter {
 	return nil
 }
 
+func (m *JSONElement) GetCustom() *JSONElement_Custom {
+	if x, ok := m.GetBody().(*JSONElement_Custom_); ok {
+		return x.Custom
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*JSONElement) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1280,6 +1492,7 @@ func (*JSONElement) XXX_OneofWrappers() []interface{} {
 		(*JSONElement_Primitive_)(nil),
 		(*JSONElement_Text_)(nil),
 		(*JSONElement_Counter_)(nil),
+		(*JSONElement_Custom_)(nil),
 	}
 }
 
@@ -1509,6 +1722,7 @@ type JSONElement_Text struct {
 	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
 	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	ActorIds             [][]byte    `protobuf:"bytes,5,rep,name=actor_ids,json=actorIds,proto3" json:"actor_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1575,6 +1789,13 @@ func (m *JSONElement_Text) GetRemovedAt() *TimeTicket {
 	return nil
 }
 
+func (m *JSONElement_Text) GetActorIds() [][]byte {
+	if m != nil {
+		return m.ActorIds
+	}
+	return nil
+}
+
 type JSONElement_Counter struct {
 	Type                 ValueType   `protobuf:"varint,1,opt,name=type,proto3,enum=yorkie.v1.ValueType" json:"type,omitempty"`
 	Value                []byte      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -1654,6 +1875,53 @@ func (m *JSONElement_Counter) GetRemovedAt() *TimeTicket {
 	return nil
 }
 
+type JSONElement_Custom struct {
+	Value                []byte   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JSONElement_Custom) Reset()         { *m = JSONElement_Custom{} }
+func (m *JSONElement_Custom) String() string { return proto.CompactTextString(m) }
+func (*JSONElement_Custom) ProtoMessage()    {}
+func (*JSONElement_Custom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{5, 5}
+}
+func (m *JSONElement_Custom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONElement_Custom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElement_Custom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JSONElement_Custom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElement_Custom.Merge(m, src)
+}
+func (m *JSONElement_Custom) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONElement_Custom) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElement_Custom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONElement_Custom proto.InternalMessageInfo
+
+func (m *JSONElement_Custom) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 type RHTNode struct {
 	Key                  string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Element              *JSONElement `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
@@ -1767,6 +2035,7 @@ func (m *RGANode) GetElement() *JSONElement {
 type TextNodeAttr struct {
 	Value                string      `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	UpdatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ValueType            ValueType   `protobuf:"varint,3,opt,name=value_type,json=valueType,proto3,enum=yorkie.v1.ValueType" json:"value_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1819,12 +2088,22 @@ func (m *TextNodeAttr) GetUpdatedAt() *TimeTicket {
 	return nil
 }
 
+func (m *TextNodeAttr) GetValueType() ValueType {
+	if m != nil {
+		return m.ValueType
+	}
+	return ValueType_VALUE_TYPE_NULL
+}
+
 type TextNode struct {
 	Id                   *TextNodeID              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Value                string                   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	RemovedAt            *TimeTicket              `protobuf:"bytes,3,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
 	InsPrevId            *TextNodeID              `protobuf:"bytes,4,opt,name=ins_prev_id,json=insPrevId,proto3" json:"ins_prev_id,omitempty"`
 	Attributes           map[string]*TextNodeAttr `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Embed                *TextEmbed               `protobuf:"bytes,6,opt,name=embed,proto3" json:"embed,omitempty"`
+	CompactId            []byte                   `protobuf:"bytes,7,opt,name=compact_id,json=compactId,proto3" json:"compact_id,omitempty"`
+	CompactInsPrevId     []byte                   `protobuf:"bytes,8,opt,name=compact_ins_prev_id,json=compactInsPrevId,proto3" json:"compact_ins_prev_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -1898,6 +2177,74 @@ func (m *TextNode) GetAttributes() map[string]*TextNodeAttr {
 	return nil
 }
 
+func (m *TextNode) GetEmbed() *TextEmbed {
+	if m != nil {
+		return m.Embed
+	}
+	return nil
+}
+
+func (m *TextNode) GetCompactId() []byte {
+	if m != nil {
+		return m.CompactId
+	}
+	return nil
+}
+
+func (m *TextNode) GetCompactInsPrevId() []byte {
+	if m != nil {
+		return m.CompactInsPrevId
+	}
+	return nil
+}
+
+type TextEmbed struct {
+	Payload              map[string]string `protobuf:"bytes,1,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TextEmbed) Reset()         { *m = TextEmbed{} }
+func (m *TextEmbed) String() string { return proto.CompactTextString(m) }
+func (*TextEmbed) ProtoMessage()    {}
+func (*TextEmbed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{10}
+}
+func (m *TextEmbed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextEmbed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextEmbed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TextEmbed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextEmbed.Merge(m, src)
+}
+func (m *TextEmbed) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextEmbed) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextEmbed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextEmbed proto.InternalMessageInfo
+
+func (m *TextEmbed) GetPayload() map[string]string {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 type TextNodeID struct {
 	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Offset               int32       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
@@ -1910,7 +2257,7 @@ func (m *TextNodeID) Reset()         { *m = TextNodeID{} }
 func (m *TextNodeID) String() string { return proto.CompactTextString(m) }
 func (*TextNodeID) ProtoMessage()    {}
 func (*TextNodeID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{10}
+	return fileDescriptor_36361b2f5d0f0896, []int{11}
 }
 func (m *TextNodeID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1966,7 +2313,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{11}
+	return fileDescriptor_36361b2f5d0f0896, []int{12}
 }
 func (m *User) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2035,7 +2382,7 @@ func (m *Project) Reset()         { *m = Project{} }
 func (m *Project) String() string { return proto.CompactTextString(m) }
 func (*Project) ProtoMessage()    {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{12}
+	return fileDescriptor_36361b2f5d0f0896, []int{13}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2141,7 +2488,7 @@ func (m *UpdatableProjectFields) Reset()         { *m = UpdatableProjectFields{}
 func (m *UpdatableProjectFields) String() string { return proto.CompactTextString(m) }
 func (*UpdatableProjectFields) ProtoMessage()    {}
 func (*UpdatableProjectFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{13}
+	return fileDescriptor_36361b2f5d0f0896, []int{14}
 }
 func (m *UpdatableProjectFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2213,7 +2560,7 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) String() string {
 }
 func (*UpdatableProjectFields_AuthWebhookMethods) ProtoMessage() {}
 func (*UpdatableProjectFields_AuthWebhookMethods) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{13, 0}
+	return fileDescriptor_36361b2f5d0f0896, []int{14, 0}
 }
 func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2265,7 +2612,7 @@ func (m *DocumentSummary) Reset()         { *m = DocumentSummary{} }
 func (m *DocumentSummary) String() string { return proto.CompactTextString(m) }
 func (*DocumentSummary) ProtoMessage()    {}
 func (*DocumentSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{14}
+	return fileDescriptor_36361b2f5d0f0896, []int{15}
 }
 func (m *DocumentSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2348,7 +2695,7 @@ func (m *Presence) Reset()         { *m = Presence{} }
 func (m *Presence) String() string { return proto.CompactTextString(m) }
 func (*Presence) ProtoMessage()    {}
 func (*Presence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{15}
+	return fileDescriptor_36361b2f5d0f0896, []int{16}
 }
 func (m *Presence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2403,7 +2750,7 @@ func (m *Client) Reset()         { *m = Client{} }
 func (m *Client) String() string { return proto.CompactTextString(m) }
 func (*Client) ProtoMessage()    {}
 func (*Client) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{16}
+	return fileDescriptor_36361b2f5d0f0896, []int{17}
 }
 func (m *Client) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2457,7 +2804,7 @@ func (m *Clients) Reset()         { *m = Clients{} }
 func (m *Clients) String() string { return proto.CompactTextString(m) }
 func (*Clients) ProtoMessage()    {}
 func (*Clients) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{17}
+	return fileDescriptor_36361b2f5d0f0896, []int{18}
 }
 func (m *Clients) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2505,7 +2852,7 @@ func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
 func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
 func (*Checkpoint) ProtoMessage()    {}
 func (*Checkpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{18}
+	return fileDescriptor_36361b2f5d0f0896, []int{19}
 }
 func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2561,7 +2908,7 @@ func (m *TextNodePos) Reset()         { *m = TextNodePos{} }
 func (m *TextNodePos) String() string { return proto.CompactTextString(m) }
 func (*TextNodePos) ProtoMessage()    {}
 func (*TextNodePos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{19}
+	return fileDescriptor_36361b2f5d0f0896, []int{20}
 }
 func (m *TextNodePos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2624,7 +2971,7 @@ func (m *TimeTicket) Reset()         { *m = TimeTicket{} }
 func (m *TimeTicket) String() string { return proto.CompactTextString(m) }
 func (*TimeTicket) ProtoMessage()    {}
 func (*TimeTicket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{20}
+	return fileDescriptor_36361b2f5d0f0896, []int{21}
 }
 func (m *TimeTicket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2687,7 +3034,7 @@ func (m *DocEvent) Reset()         { *m = DocEvent{} }
 func (m *DocEvent) String() string { return proto.CompactTextString(m) }
 func (*DocEvent) ProtoMessage()    {}
 func (*DocEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{21}
+	return fileDescriptor_36361b2f5d0f0896, []int{22}
 }
 func (m *DocEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2754,7 +3101,11 @@ func init() {
 	proto.RegisterType((*Operation_Select)(nil), "yorkie.v1.Operation.Select")
 	proto.RegisterType((*Operation_Style)(nil), "yorkie.v1.Operation.Style")
 	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.Operation.Style.AttributesEntry")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "yorkie.v1.Operation.Style.CreatedAtMapByActorEntry")
+	proto.RegisterType((*Operation_RemoveStyle)(nil), "yorkie.v1.Operation.RemoveStyle")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "yorkie.v1.Operation.RemoveStyle.CreatedAtMapByActorEntry")
 	proto.RegisterType((*Operation_Increase)(nil), "yorkie.v1.Operation.Increase")
+	proto.RegisterType((*Operation_SetTree)(nil), "yorkie.v1.Operation.SetTree")
 	proto.RegisterType((*JSONElementSimple)(nil), "yorkie.v1.JSONElementSimple")
 	proto.RegisterType((*JSONElement)(nil), "yorkie.v1.JSONElement")
 	proto.RegisterType((*JSONElement_JSONObject)(nil), "yorkie.v1.JSONElement.JSONObject")
@@ -2762,11 +3113,14 @@ func init() {
 	proto.RegisterType((*JSONElement_Primitive)(nil), "yorkie.v1.JSONElement.Primitive")
 	proto.RegisterType((*JSONElement_Text)(nil), "yorkie.v1.JSONElement.Text")
 	proto.RegisterType((*JSONElement_Counter)(nil), "yorkie.v1.JSONElement.Counter")
+	proto.RegisterType((*JSONElement_Custom)(nil), "yorkie.v1.JSONElement.Custom")
 	proto.RegisterType((*RHTNode)(nil), "yorkie.v1.RHTNode")
 	proto.RegisterType((*RGANode)(nil), "yorkie.v1.RGANode")
 	proto.RegisterType((*TextNodeAttr)(nil), "yorkie.v1.TextNodeAttr")
 	proto.RegisterType((*TextNode)(nil), "yorkie.v1.TextNode")
 	proto.RegisterMapType((map[string]*TextNodeAttr)(nil), "yorkie.v1.TextNode.AttributesEntry")
+	proto.RegisterType((*TextEmbed)(nil), "yorkie.v1.TextEmbed")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.TextEmbed.PayloadEntry")
 	proto.RegisterType((*TextNodeID)(nil), "yorkie.v1.TextNodeID")
 	proto.RegisterType((*User)(nil), "yorkie.v1.User")
 	proto.RegisterType((*Project)(nil), "yorkie.v1.Project")
@@ -2786,146 +3140,164 @@ func init() {
 func init() { proto.RegisterFile("yorkie/v1/resources.proto", fileDescriptor_36361b2f5d0f0896) }
 
 var fileDescriptor_36361b2f5d0f0896 = []byte{
-	// 2222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdf, 0x8f, 0xdb, 0x58,
-	0xf5, 0x8f, 0x1d, 0xe7, 0x87, 0x4f, 0xa6, 0x9d, 0xf4, 0x4e, 0x7f, 0xa4, 0x69, 0x3b, 0x3b, 0x4d,
-	0xbf, 0xdf, 0x32, 0xdb, 0x42, 0xa6, 0x1d, 0xda, 0x05, 0xb6, 0x5a, 0x84, 0x27, 0xf1, 0x36, 0xd3,
-	0x9d, 0x66, 0x46, 0x4e, 0xa6, 0x4b, 0x2b, 0x90, 0xe5, 0xb1, 0x6f, 0x3b, 0xde, 0x49, 0x6c, 0xaf,
-	0xed, 0x64, 0x9b, 0x07, 0x5e, 0x10, 0x48, 0x3c, 0xc0, 0x3b, 0x7f, 0x00, 0x12, 0x7f, 0xc3, 0x3e,
-	0x21, 0xf1, 0x80, 0x78, 0x03, 0x04, 0x12, 0xaf, 0xd0, 0x7d, 0x40, 0x3c, 0x02, 0x12, 0x6f, 0x48,
-	0xe8, 0xde, 0x6b, 0x3b, 0x8e, 0xe3, 0x64, 0xd3, 0x30, 0x42, 0x5d, 0xde, 0x7c, 0xef, 0xfd, 0x9c,
-	0x73, 0xcf, 0xcf, 0x7b, 0x8e, 0xef, 0x85, 0xcb, 0x23, 0xdb, 0x3d, 0x31, 0xf1, 0xd6, 0xf0, 0xee,
-	0x96, 0x8b, 0x3d, 0x7b, 0xe0, 0xea, 0xd8, 0xab, 0x3b, 0xae, 0xed, 0xdb, 0x48, 0x64, 0x4b, 0xf5,
-	0xe1, 0xdd, 0xea, 0x5b, 0x2f, 0x6c, 0xfb, 0x45, 0x0f, 0x6f, 0xd1, 0x85, 0xa3, 0xc1, 0xf3, 0x2d,
-	0xdf, 0xec, 0x63, 0xcf, 0xd7, 0xfa, 0x0e, 0xc3, 0x56, 0xd7, 0x93, 0x80, 0x4f, 0x5c, 0xcd, 0x71,
-	0xb0, 0x1b, 0xf0, 0xaa, 0xfd, 0x9d, 0x03, 0x68, 0x1c, 0x6b, 0xd6, 0x0b, 0x7c, 0xa0, 0xe9, 0x27,
-	0xe8, 0x3a, 0xac, 0x18, 0xb6, 0x3e, 0xe8, 0x63, 0xcb, 0x57, 0x4f, 0xf0, 0xa8, 0xc2, 0x6d, 0x70,
-	0x9b, 0xa2, 0x52, 0x0a, 0xe7, 0x3e, 0xc0, 0x23, 0x74, 0x1f, 0x40, 0x3f, 0xc6, 0xfa, 0x89, 0x63,
-	0x9b, 0x96, 0x5f, 0xe1, 0x37, 0xb8, 0xcd, 0xd2, 0xf6, 0x85, 0x7a, 0x24, 0x52, 0xbd, 0x11, 0x2d,
-	0x2a, 0x31, 0x20, 0xaa, 0x42, 0xd1, 0xb3, 0x34, 0xc7, 0x3b, 0xb6, 0xfd, 0x4a, 0x76, 0x83, 0xdb,
-	0x5c, 0x51, 0xa2, 0x31, 0xba, 0x0d, 0x05, 0x9d, 0xca, 0xe0, 0x55, 0x84, 0x8d, 0xec, 0x66, 0x69,
-	0xfb, 0xdc, 0x04, 0x3f, 0xb2, 0xa2, 0x84, 0x08, 0x24, 0xc1, 0xb9, 0xbe, 0x69, 0xa9, 0xde, 0xc8,
-	0xd2, 0xb1, 0xa1, 0xfa, 0xa6, 0x7e, 0x82, 0xfd, 0x4a, 0x6e, 0x4a, 0x8c, 0xae, 0xd9, 0xc7, 0x5d,
-	0xba, 0xa8, 0xac, 0xf6, 0x4d, 0xab, 0x43, 0xe1, 0x6c, 0xa2, 0xf6, 0x3d, 0xc8, 0x33, 0xae, 0xe8,
-	0x06, 0xf0, 0xa6, 0x41, 0xb5, 0x2c, 0x6d, 0xaf, 0x4d, 0x6d, 0xba, 0xdb, 0x54, 0x78, 0xd3, 0x40,
-	0x15, 0x28, 0xf4, 0xb1, 0xe7, 0x69, 0x2f, 0x30, 0x55, 0x57, 0x54, 0xc2, 0x21, 0xba, 0x07, 0x60,
-	0x3b, 0xd8, 0xd5, 0x7c, 0xd3, 0xb6, 0xbc, 0x4a, 0x96, 0xca, 0x7e, 0x3e, 0xc6, 0x66, 0x3f, 0x5c,
-	0x54, 0x62, 0xb8, 0xda, 0x0f, 0x39, 0x28, 0x86, 0x1b, 0xa0, 0x6b, 0x00, 0x7a, 0xcf, 0x24, 0xf6,
-	0xf6, 0xf0, 0xc7, 0x54, 0x92, 0x33, 0x8a, 0xc8, 0x66, 0x3a, 0xf8, 0x63, 0x74, 0x1d, 0xc0, 0xc3,
-	0xee, 0x10, 0xbb, 0x74, 0x99, 0x6c, 0x9f, 0xdd, 0xe1, 0xef, 0x70, 0x8a, 0xc8, 0x66, 0x09, 0xe4,
-	0x2a, 0x14, 0x7a, 0x5a, 0xdf, 0xb1, 0x5d, 0x66, 0x58, 0xb6, 0x1e, 0x4e, 0xa1, 0xcb, 0x50, 0xd4,
-	0x74, 0xdf, 0x76, 0x55, 0xd3, 0xa8, 0x08, 0xd4, 0xee, 0x05, 0x3a, 0xde, 0x35, 0x6a, 0x9f, 0x9d,
-	0x07, 0x31, 0x92, 0x10, 0x7d, 0x19, 0xb2, 0x1e, 0xf6, 0x03, 0x5b, 0x54, 0xd2, 0x94, 0xa8, 0x77,
-	0xb0, 0xdf, 0xca, 0x28, 0x04, 0x46, 0xd0, 0x9a, 0x61, 0x04, 0xee, 0x4f, 0x47, 0x4b, 0x86, 0x41,
-	0xd0, 0x9a, 0x61, 0xa0, 0x2d, 0x10, 0xfa, 0xf6, 0x10, 0x53, 0xf9, 0x4a, 0xdb, 0x97, 0x53, 0xe1,
-	0x8f, 0xed, 0x21, 0x6e, 0x65, 0x14, 0x0a, 0x44, 0xf7, 0x21, 0xef, 0x62, 0x4a, 0x22, 0x50, 0x92,
-	0x2b, 0xa9, 0x24, 0x0a, 0x85, 0xb4, 0x32, 0x4a, 0x00, 0x26, 0xfb, 0x60, 0xc3, 0x0c, 0xc3, 0x21,
-	0x7d, 0x1f, 0xd9, 0x30, 0x89, 0x16, 0x14, 0x48, 0xf6, 0xf1, 0x70, 0x0f, 0xeb, 0x7e, 0x25, 0x3f,
-	0x67, 0x9f, 0x0e, 0x85, 0x90, 0x7d, 0x18, 0x18, 0x6d, 0x43, 0xce, 0xf3, 0x47, 0x3d, 0x5c, 0x29,
-	0x50, 0xaa, 0x6a, 0x3a, 0x15, 0x41, 0xb4, 0x32, 0x0a, 0x83, 0xa2, 0x07, 0x50, 0x34, 0x2d, 0xdd,
-	0xc5, 0x9a, 0x87, 0x2b, 0x45, 0x4a, 0x76, 0x2d, 0x95, 0x6c, 0x37, 0x00, 0xb5, 0x32, 0x4a, 0x44,
-	0x50, 0xfd, 0x15, 0x07, 0xd9, 0x0e, 0xf6, 0x49, 0xf0, 0x3b, 0x9a, 0x4b, 0xa2, 0x85, 0x2c, 0xf8,
-	0xd8, 0x50, 0xb5, 0xd0, 0x65, 0xb3, 0x82, 0x9f, 0xe1, 0x1b, 0x0c, 0x2e, 0xf9, 0xa8, 0x0c, 0x59,
-	0x92, 0xd9, 0x2c, 0x92, 0xc9, 0x27, 0xd1, 0x66, 0xa8, 0xf5, 0x06, 0xa1, 0x7b, 0xae, 0xc6, 0x18,
-	0x3d, 0xea, 0xec, 0xb7, 0xe5, 0x1e, 0x26, 0xb9, 0xdf, 0x31, 0xfb, 0x4e, 0x0f, 0x2b, 0x0c, 0x8a,
-	0xde, 0x81, 0x12, 0x7e, 0x89, 0xf5, 0x41, 0x20, 0x82, 0x30, 0x4f, 0x04, 0x08, 0x91, 0x92, 0x5f,
-	0xfd, 0x07, 0x07, 0x59, 0xc9, 0x30, 0x4e, 0x43, 0x91, 0xf7, 0x60, 0xd5, 0x71, 0xf1, 0x30, 0xce,
-	0x80, 0x9f, 0xc7, 0xe0, 0x0c, 0x41, 0x8f, 0xc9, 0xff, 0x9b, 0x5a, 0xff, 0x93, 0x03, 0x81, 0xc4,
-	0xf7, 0x1b, 0xa0, 0xf6, 0x3d, 0x80, 0x18, 0x65, 0x76, 0x1e, 0xa5, 0xa8, 0x47, 0x54, 0xcb, 0x2a,
-	0xfe, 0x29, 0x07, 0x79, 0x96, 0xa5, 0xa7, 0xa1, 0xfa, 0xa4, 0xec, 0xfc, 0x72, 0xb2, 0x67, 0x17,
-	0x95, 0xfd, 0x97, 0x02, 0x08, 0xe4, 0xb0, 0x38, 0x0d, 0xc9, 0x6f, 0x81, 0xf0, 0xdc, 0xb5, 0xfb,
-	0x81, 0xcc, 0x17, 0xe3, 0x54, 0xf8, 0xa5, 0xdf, 0xb6, 0x0d, 0x7c, 0x60, 0x7b, 0x0a, 0xc5, 0xa0,
-	0x9b, 0xc0, 0xfb, 0x76, 0x20, 0xe6, 0x2c, 0x24, 0xef, 0xdb, 0xe8, 0x18, 0x2e, 0x8d, 0xe5, 0x51,
-	0xfb, 0x9a, 0xa3, 0x1e, 0x8d, 0x54, 0x7a, 0xb6, 0x07, 0x55, 0x74, 0x7b, 0xe6, 0xf9, 0x57, 0x8f,
-	0x24, 0x7b, 0xac, 0x39, 0x3b, 0x23, 0x89, 0x10, 0xc9, 0x96, 0xef, 0x8e, 0x94, 0x35, 0x7d, 0x7a,
-	0x85, 0x14, 0x40, 0xdd, 0xb6, 0x7c, 0x6c, 0xb1, 0x93, 0x55, 0x54, 0xc2, 0x61, 0xd2, 0xb6, 0xf9,
-	0x05, 0x6d, 0x8b, 0x76, 0x01, 0x34, 0xdf, 0x77, 0xcd, 0xa3, 0x81, 0x8f, 0xbd, 0x4a, 0x81, 0x8a,
-	0xfb, 0xf6, 0x6c, 0x71, 0xa5, 0x08, 0xcb, 0xa4, 0x8c, 0x11, 0x57, 0xbf, 0x0b, 0x95, 0x59, 0xda,
-	0x84, 0x67, 0x1d, 0x37, 0x3e, 0xeb, 0x6e, 0x87, 0x59, 0x3f, 0x37, 0x7a, 0x18, 0xe6, 0x5d, 0xfe,
-	0xeb, 0x5c, 0xf5, 0x3d, 0x58, 0x4d, 0xec, 0x9e, 0xc2, 0xf5, 0x7c, 0x9c, 0xab, 0x18, 0x27, 0xff,
-	0x23, 0x07, 0x79, 0x56, 0x3e, 0xde, 0xd4, 0x30, 0x5a, 0x36, 0xb5, 0xff, 0xcc, 0x43, 0x8e, 0x96,
-	0xb8, 0x37, 0x55, 0xb1, 0x47, 0x13, 0x31, 0xc6, 0x52, 0xe2, 0xd6, 0xec, 0x4a, 0x3d, 0x2f, 0xc8,
-	0x92, 0x46, 0xca, 0x2d, 0x6a, 0xa4, 0xff, 0x30, 0x7a, 0x3e, 0xe5, 0xa0, 0x18, 0xf6, 0x03, 0xa7,
-	0x61, 0xe6, 0xed, 0xc9, 0xe8, 0x5f, 0xa6, 0xe6, 0x2d, 0x7a, 0x7c, 0xee, 0xe4, 0x41, 0x38, 0xb2,
-	0x8d, 0x51, 0xed, 0x6f, 0x1c, 0x9c, 0x9b, 0x62, 0x9e, 0x38, 0xca, 0xb9, 0x05, 0x8f, 0xf2, 0x3b,
-	0x50, 0x24, 0xb5, 0xe4, 0xf3, 0x8f, 0xff, 0x02, 0x85, 0xb1, 0x92, 0xc1, 0x7a, 0xc3, 0x05, 0xca,
-	0x5d, 0x00, 0x94, 0x7c, 0xb4, 0x09, 0x82, 0x3f, 0x72, 0x58, 0xf3, 0x79, 0x76, 0xa2, 0xa3, 0x7f,
-	0x42, 0x6c, 0xd2, 0x1d, 0x39, 0x58, 0xa1, 0x88, 0xb1, 0xef, 0x72, 0xb4, 0xb7, 0x66, 0x83, 0xda,
-	0xcf, 0x4a, 0x50, 0x8a, 0xe9, 0x8c, 0x9a, 0x50, 0xfa, 0xc8, 0xb3, 0x2d, 0xd5, 0x3e, 0xfa, 0x88,
-	0xf4, 0x9a, 0x4c, 0xdd, 0xeb, 0xe9, 0xd6, 0xa7, 0xdf, 0xfb, 0x14, 0xd8, 0xca, 0x28, 0x40, 0xe8,
-	0xd8, 0x08, 0x49, 0x40, 0x47, 0xaa, 0xe6, 0xba, 0xda, 0x28, 0xd0, 0x7f, 0x63, 0x0e, 0x13, 0x89,
-	0xe0, 0x5a, 0x19, 0x45, 0x24, 0x54, 0x74, 0x80, 0xbe, 0x05, 0xa2, 0xe3, 0x9a, 0x7d, 0xd3, 0x37,
-	0xa3, 0x6e, 0x7c, 0x16, 0x87, 0x83, 0x10, 0x47, 0x38, 0x44, 0x44, 0xe8, 0x2e, 0x08, 0x3e, 0x7e,
-	0x19, 0xa6, 0xc0, 0x95, 0x19, 0xc4, 0x24, 0x17, 0x49, 0x93, 0x4d, 0xa0, 0xe8, 0x5d, 0x52, 0x3e,
-	0x06, 0x96, 0x8f, 0xdd, 0xa0, 0x40, 0xac, 0xcf, 0xa0, 0x6a, 0x30, 0x54, 0x2b, 0xa3, 0x84, 0x04,
-	0xd5, 0x3f, 0x70, 0x00, 0x63, 0x83, 0xa0, 0x4d, 0xc8, 0x59, 0xb6, 0x81, 0xbd, 0x0a, 0x47, 0xd3,
-	0x19, 0xc5, 0x18, 0x29, 0xad, 0x2e, 0xc9, 0x7e, 0x85, 0x01, 0x96, 0xec, 0x15, 0xe2, 0x01, 0x96,
-	0x5d, 0x22, 0xc0, 0x84, 0xc5, 0x02, 0xac, 0xfa, 0x7b, 0x0e, 0xc4, 0xc8, 0x45, 0x73, 0xb5, 0x7a,
-	0x28, 0x7d, 0x71, 0xb4, 0xfa, 0x2b, 0x07, 0x62, 0x14, 0x36, 0x51, 0x12, 0x71, 0x8b, 0x27, 0x11,
-	0x1f, 0x4b, 0xa2, 0x25, 0x3b, 0xd5, 0xb8, 0xae, 0xc2, 0x12, 0xba, 0xe6, 0x16, 0xd4, 0xf5, 0x37,
-	0x1c, 0x08, 0x24, 0xca, 0xd1, 0xdb, 0x93, 0xce, 0x5b, 0x4b, 0xa9, 0x48, 0x5f, 0x0c, 0xef, 0xfd,
-	0x85, 0x83, 0x42, 0x90, 0x81, 0xff, 0xdb, 0xbe, 0x8b, 0x4a, 0xd3, 0x63, 0x28, 0x04, 0xa7, 0x46,
-	0x4a, 0x55, 0xbe, 0x03, 0x05, 0xcc, 0xce, 0xa5, 0x94, 0xae, 0x24, 0x76, 0x6a, 0x29, 0x21, 0xac,
-	0xa6, 0x43, 0x21, 0x48, 0x57, 0x74, 0x13, 0x04, 0x8b, 0x9c, 0x92, 0xec, 0xa4, 0x4f, 0x4b, 0x68,
-	0xba, 0xbe, 0xc4, 0x26, 0xcf, 0x60, 0x25, 0x0c, 0x2b, 0xd2, 0x59, 0x8c, 0xed, 0xcf, 0xc5, 0x9a,
-	0x07, 0x62, 0x97, 0x81, 0x63, 0x2c, 0x16, 0x69, 0x01, 0x50, 0xf2, 0x6b, 0xbf, 0xe3, 0xa1, 0x18,
-	0x32, 0x47, 0xff, 0x1f, 0xbb, 0x1a, 0xbb, 0x90, 0x12, 0xd4, 0xc1, 0xe5, 0x58, 0x6a, 0xf3, 0xb2,
-	0x64, 0xd9, 0xbd, 0x0f, 0x25, 0xd3, 0xf2, 0x54, 0xfa, 0x7b, 0x1b, 0x5c, 0x57, 0xcd, 0xdc, 0x5b,
-	0x34, 0x2d, 0xef, 0xc0, 0xc5, 0xc3, 0x5d, 0x03, 0x35, 0x26, 0x1a, 0xbd, 0x1c, 0x4d, 0xc3, 0x1b,
-	0x29, 0x54, 0x73, 0x7f, 0x23, 0x9e, 0x2c, 0xd2, 0xa9, 0x7d, 0x65, 0xb2, 0x7f, 0xba, 0x94, 0xb2,
-	0x09, 0x61, 0x12, 0x6b, 0xe1, 0x6a, 0xcf, 0x00, 0xc6, 0x52, 0x2f, 0xd9, 0xf6, 0x5c, 0x84, 0xbc,
-	0xfd, 0xfc, 0xb9, 0x87, 0x99, 0x27, 0x73, 0x4a, 0x30, 0xaa, 0xf5, 0x41, 0x38, 0xf4, 0xb0, 0x8b,
-	0xce, 0x46, 0xae, 0x12, 0xa9, 0x4f, 0xaa, 0x50, 0x1c, 0x78, 0xd8, 0xb5, 0xb4, 0x7e, 0xe8, 0x96,
-	0x68, 0x8c, 0xbe, 0x91, 0x92, 0x99, 0xd5, 0x3a, 0xbb, 0x25, 0xae, 0x87, 0xb7, 0xc4, 0x54, 0x0e,
-	0x7a, 0x8d, 0x1c, 0x13, 0xa3, 0xf6, 0x2f, 0x1e, 0x0a, 0x07, 0xae, 0x4d, 0x0b, 0x71, 0x72, 0x4b,
-	0x04, 0x42, 0x6c, 0x3b, 0xfa, 0x8d, 0xae, 0x01, 0x38, 0x83, 0xa3, 0x9e, 0xa9, 0xd3, 0xab, 0xe4,
-	0x2c, 0x5d, 0x11, 0xd9, 0xcc, 0x07, 0x78, 0x44, 0x96, 0x3d, 0xac, 0xbb, 0x98, 0xdd, 0x34, 0x0b,
-	0x6c, 0x99, 0xcd, 0x90, 0xe5, 0x4d, 0x28, 0x6b, 0x03, 0xff, 0x58, 0xfd, 0x04, 0x1f, 0x1d, 0xdb,
-	0xf6, 0x89, 0x3a, 0x70, 0x7b, 0xc1, 0xdf, 0xe7, 0x59, 0x32, 0xff, 0x21, 0x9b, 0x3e, 0x74, 0x7b,
-	0xe8, 0x0e, 0x9c, 0x9f, 0x40, 0xf6, 0xb1, 0x7f, 0x6c, 0x1b, 0x5e, 0x25, 0xbf, 0x91, 0xdd, 0x14,
-	0x15, 0x14, 0x43, 0x3f, 0x66, 0x2b, 0xe8, 0x9b, 0x70, 0x25, 0xb8, 0x74, 0x35, 0xb0, 0xa6, 0xfb,
-	0xe6, 0x50, 0xf3, 0xb1, 0xea, 0x1f, 0xbb, 0xd8, 0x3b, 0xb6, 0x7b, 0x06, 0xbd, 0xd5, 0x13, 0x95,
-	0xcb, 0x0c, 0xd2, 0x8c, 0x10, 0xdd, 0x10, 0x90, 0x30, 0x62, 0xf1, 0x35, 0x8c, 0x48, 0x48, 0x63,
-	0x99, 0x29, 0x7e, 0x3e, 0xe9, 0x38, 0x3d, 0x7f, 0x94, 0x85, 0x8b, 0x87, 0x64, 0xa4, 0x1d, 0xf5,
-	0x70, 0xe0, 0x88, 0xf7, 0x4d, 0xdc, 0x33, 0x3c, 0x74, 0x27, 0x30, 0x3f, 0x17, 0xf4, 0xf5, 0x49,
-	0x7e, 0x1d, 0xdf, 0x35, 0xad, 0x17, 0xf4, 0xec, 0x0e, 0x9c, 0xf3, 0x7e, 0x8a, 0x79, 0xf9, 0x05,
-	0xa8, 0x93, 0xc6, 0x7f, 0x3e, 0xc3, 0xf8, 0x2c, 0xb2, 0xee, 0xc5, 0x62, 0x3b, 0x5d, 0xf4, 0xba,
-	0x34, 0xe5, 0x9e, 0x54, 0x97, 0x7d, 0x67, 0xbe, 0xcb, 0x84, 0x05, 0x44, 0x9f, 0xed, 0xd0, 0x6a,
-	0x1d, 0xd0, 0xb4, 0x1c, 0xec, 0xe2, 0x9f, 0xa9, 0xc3, 0xd1, 0x58, 0x0a, 0x87, 0xb5, 0xef, 0xf3,
-	0xb0, 0xda, 0x0c, 0x1e, 0x45, 0x3a, 0x83, 0x7e, 0x5f, 0x73, 0x47, 0x53, 0x29, 0x31, 0x7d, 0xd1,
-	0x9a, 0x7c, 0x03, 0x11, 0x63, 0x6f, 0x20, 0x93, 0x21, 0x25, 0xbc, 0x4e, 0x48, 0x3d, 0x80, 0x92,
-	0xa6, 0xeb, 0xd8, 0xf3, 0xe2, 0x55, 0x70, 0x1e, 0x2d, 0x84, 0xf0, 0xa9, 0x78, 0xcc, 0xbf, 0x4e,
-	0x3c, 0xfe, 0x98, 0x83, 0xe2, 0x81, 0x8b, 0x3d, 0x6c, 0xe9, 0xb4, 0x0f, 0xd0, 0x7b, 0xb6, 0x7e,
-	0x42, 0x0d, 0x90, 0x53, 0xd8, 0x80, 0xfc, 0x2d, 0x10, 0xa7, 0x57, 0x78, 0x7a, 0x28, 0xc7, 0x2f,
-	0xbc, 0x43, 0xc2, 0x7a, 0x53, 0xf3, 0x35, 0x76, 0x1c, 0x53, 0x68, 0xf5, 0x6b, 0x20, 0x46, 0x53,
-	0xaf, 0xf3, 0xb3, 0x5c, 0xdb, 0x85, 0x7c, 0x83, 0x3a, 0x38, 0xe6, 0x89, 0x15, 0xea, 0x89, 0x2d,
-	0x28, 0x3a, 0xc1, 0x76, 0x41, 0x8c, 0xaf, 0xa5, 0x48, 0xa2, 0x44, 0xa0, 0xda, 0x3b, 0x50, 0x60,
-	0xac, 0x3c, 0xfa, 0x36, 0xc5, 0x3e, 0x83, 0x06, 0x6f, 0xe2, 0x6d, 0x8a, 0xae, 0x28, 0x21, 0xa2,
-	0xd6, 0x06, 0x18, 0x3f, 0x7f, 0x25, 0xde, 0x6e, 0xb8, 0xb4, 0xb7, 0x9b, 0xc9, 0xd7, 0x1f, 0x3e,
-	0xf1, 0xfa, 0x53, 0xfb, 0x01, 0x07, 0xa5, 0xd8, 0xb5, 0xc6, 0xe9, 0x96, 0x0f, 0xf4, 0x25, 0x58,
-	0x75, 0x71, 0x4f, 0x23, 0xcd, 0xba, 0x1a, 0x00, 0xb2, 0x14, 0x70, 0x36, 0x9c, 0xde, 0x67, 0x75,
-	0x46, 0x07, 0x18, 0x73, 0x8e, 0xbf, 0x37, 0x71, 0xd3, 0xef, 0x4d, 0x57, 0x41, 0x34, 0x70, 0x8f,
-	0xfc, 0x03, 0x60, 0x37, 0x54, 0x28, 0x9a, 0x98, 0x78, 0x8d, 0xca, 0x4e, 0xbe, 0x46, 0xfd, 0x84,
-	0x83, 0x62, 0xd3, 0xd6, 0xe5, 0x21, 0xf1, 0xe0, 0xed, 0x89, 0xfe, 0x33, 0x5e, 0x67, 0x43, 0x48,
-	0xac, 0x05, 0xdd, 0x02, 0x56, 0x55, 0xbc, 0xe3, 0x60, 0xcb, 0x54, 0x27, 0x8d, 0x31, 0xe8, 0x06,
-	0x9c, 0x89, 0xbf, 0x72, 0xb2, 0x97, 0x3b, 0x51, 0x59, 0x89, 0x3d, 0x73, 0x7a, 0xb7, 0x7e, 0xc1,
-	0x83, 0x18, 0x35, 0xbb, 0x68, 0x0d, 0x56, 0x9f, 0x48, 0x7b, 0x87, 0xb2, 0xda, 0x7d, 0x7a, 0x20,
-	0xab, 0xed, 0xc3, 0xbd, 0xbd, 0x72, 0x06, 0x5d, 0x04, 0x14, 0x9b, 0xdc, 0xd9, 0xdf, 0xdf, 0x93,
-	0xa5, 0x76, 0x99, 0x4b, 0xcc, 0xef, 0xb6, 0xbb, 0xf2, 0x43, 0x59, 0x29, 0xf3, 0x09, 0x26, 0x7b,
-	0xfb, 0xed, 0x87, 0xe5, 0x2c, 0xba, 0x00, 0xe7, 0x62, 0x93, 0xcd, 0xfd, 0xc3, 0x9d, 0x3d, 0xb9,
-	0x2c, 0x24, 0xa6, 0x3b, 0x5d, 0x65, 0xb7, 0xfd, 0xb0, 0x9c, 0x43, 0xe7, 0xa1, 0x1c, 0xdf, 0xf2,
-	0x69, 0x57, 0xee, 0x94, 0xf3, 0x09, 0xc6, 0x4d, 0xa9, 0x2b, 0x97, 0x0b, 0xa8, 0x0a, 0x17, 0x63,
-	0x93, 0xa4, 0x99, 0x54, 0xf7, 0x77, 0x1e, 0xc9, 0x8d, 0x6e, 0xb9, 0x88, 0x2e, 0xc3, 0x85, 0xe4,
-	0x9a, 0xa4, 0x28, 0xd2, 0xd3, 0xb2, 0x98, 0xe0, 0xd5, 0x95, 0xbf, 0xdd, 0x2d, 0x43, 0x82, 0x57,
-	0xa0, 0x91, 0xda, 0x68, 0x77, 0xcb, 0x25, 0x74, 0x09, 0xd6, 0x12, 0x5a, 0xd1, 0x85, 0x95, 0x5b,
-	0x3f, 0xe7, 0x60, 0x25, 0xee, 0x2e, 0xf4, 0x7f, 0xb0, 0xd1, 0xdc, 0x6f, 0xa8, 0xf2, 0x13, 0xb9,
-	0xdd, 0x0d, 0xd5, 0x6d, 0x1c, 0x3e, 0x96, 0xdb, 0xdd, 0x8e, 0xda, 0x68, 0x49, 0xed, 0x87, 0x72,
-	0xb3, 0x9c, 0x99, 0x8b, 0xfa, 0x50, 0xea, 0x36, 0x5a, 0x72, 0xb3, 0xcc, 0xa1, 0x9b, 0x50, 0x9b,
-	0x89, 0x3a, 0x6c, 0x87, 0x38, 0x1e, 0xdd, 0x80, 0xb7, 0x12, 0xb8, 0x03, 0x45, 0xee, 0xc8, 0xed,
-	0x86, 0x1c, 0x6d, 0x99, 0xdd, 0xb9, 0xfd, 0xeb, 0x57, 0xeb, 0xdc, 0x6f, 0x5f, 0xad, 0x73, 0x7f,
-	0x7a, 0xb5, 0xce, 0xfd, 0xf4, 0xb3, 0xf5, 0x0c, 0x9c, 0x33, 0xf0, 0x30, 0x8c, 0x21, 0xcd, 0x31,
-	0xeb, 0xc3, 0xbb, 0x07, 0xdc, 0x33, 0xa1, 0xfe, 0x60, 0x78, 0xf7, 0x28, 0x4f, 0x4f, 0xc5, 0xaf,
-	0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x77, 0x83, 0x5a, 0x5a, 0xa2, 0x1f, 0x00, 0x00,
+	// 2502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x45, 0xea, 0x83, 0x4f, 0x4e, 0xac, 0x8c, 0x13, 0x87, 0x51, 0x12, 0xaf, 0xa3, 0xb4,
+	0xa9, 0x37, 0xd9, 0x95, 0x13, 0x27, 0xd9, 0x6d, 0x12, 0x6c, 0x51, 0x59, 0xe6, 0xc6, 0x4e, 0x1d,
+	0xd9, 0xa0, 0xe4, 0x6c, 0xb3, 0x68, 0x41, 0xd0, 0xe4, 0x24, 0xe6, 0x5a, 0x12, 0xb5, 0xe4, 0x48,
+	0x1b, 0x1d, 0x7a, 0xe9, 0x07, 0xd0, 0x43, 0x8b, 0x5e, 0x8b, 0xfe, 0x03, 0x3d, 0xf4, 0x2f, 0xd8,
+	0x53, 0x2f, 0x45, 0x51, 0xa0, 0x97, 0x02, 0x2d, 0xd0, 0x5b, 0x51, 0xa4, 0x87, 0xa2, 0x40, 0x51,
+	0xa0, 0x2d, 0xd0, 0x5b, 0x81, 0x62, 0x66, 0x48, 0x8a, 0xa2, 0x28, 0x45, 0xd6, 0x26, 0x45, 0x76,
+	0x6f, 0x9c, 0x99, 0xdf, 0x9b, 0x79, 0x5f, 0xf3, 0xe6, 0x3d, 0xce, 0xc0, 0xb9, 0xbe, 0xe3, 0x1e,
+	0xd9, 0x78, 0xad, 0x77, 0x63, 0xcd, 0xc5, 0x9e, 0xd3, 0x75, 0x4d, 0xec, 0x95, 0x3b, 0xae, 0x43,
+	0x1c, 0x24, 0xf3, 0xa1, 0x72, 0xef, 0x46, 0xf1, 0x8d, 0xa7, 0x8e, 0xf3, 0xb4, 0x89, 0xd7, 0xd8,
+	0xc0, 0x41, 0xf7, 0xc9, 0x1a, 0xb1, 0x5b, 0xd8, 0x23, 0x46, 0xab, 0xc3, 0xb1, 0xc5, 0xe5, 0x38,
+	0xe0, 0x13, 0xd7, 0xe8, 0x74, 0xb0, 0xeb, 0xcf, 0x55, 0xfa, 0x97, 0x00, 0x50, 0x3d, 0x34, 0xda,
+	0x4f, 0xf1, 0x9e, 0x61, 0x1e, 0xa1, 0x4b, 0x30, 0x6f, 0x39, 0x66, 0xb7, 0x85, 0xdb, 0x44, 0x3f,
+	0xc2, 0x7d, 0x45, 0x58, 0x11, 0x56, 0x65, 0x2d, 0x1f, 0xf4, 0x7d, 0x03, 0xf7, 0xd1, 0x6d, 0x00,
+	0xf3, 0x10, 0x9b, 0x47, 0x1d, 0xc7, 0x6e, 0x13, 0x25, 0xb5, 0x22, 0xac, 0xe6, 0xd7, 0xcf, 0x94,
+	0x43, 0x96, 0xca, 0xd5, 0x70, 0x50, 0x8b, 0x00, 0x51, 0x11, 0x72, 0x5e, 0xdb, 0xe8, 0x78, 0x87,
+	0x0e, 0x51, 0xc4, 0x15, 0x61, 0x75, 0x5e, 0x0b, 0xdb, 0xe8, 0x1a, 0x64, 0x4d, 0xc6, 0x83, 0xa7,
+	0x48, 0x2b, 0xe2, 0x6a, 0x7e, 0xfd, 0xd4, 0xd0, 0x7c, 0x74, 0x44, 0x0b, 0x10, 0xa8, 0x02, 0xa7,
+	0x5a, 0x76, 0x5b, 0xf7, 0xfa, 0x6d, 0x13, 0x5b, 0x3a, 0xb1, 0xcd, 0x23, 0x4c, 0x94, 0xf4, 0x08,
+	0x1b, 0x0d, 0xbb, 0x85, 0x1b, 0x6c, 0x50, 0x5b, 0x68, 0xd9, 0xed, 0x3a, 0x83, 0xf3, 0x8e, 0xd2,
+	0x77, 0x20, 0xc3, 0x67, 0x45, 0x97, 0x21, 0x65, 0x5b, 0x4c, 0xca, 0xfc, 0xfa, 0xe2, 0xc8, 0xa2,
+	0xdb, 0x9b, 0x5a, 0xca, 0xb6, 0x90, 0x02, 0xd9, 0x16, 0xf6, 0x3c, 0xe3, 0x29, 0x66, 0xe2, 0xca,
+	0x5a, 0xd0, 0x44, 0xb7, 0x00, 0x9c, 0x0e, 0x76, 0x0d, 0x62, 0x3b, 0x6d, 0x4f, 0x11, 0x19, 0xef,
+	0xa7, 0x23, 0xd3, 0xec, 0x06, 0x83, 0x5a, 0x04, 0x57, 0xfa, 0x81, 0x00, 0xb9, 0x60, 0x01, 0x74,
+	0x11, 0xc0, 0x6c, 0xda, 0x54, 0xdf, 0x1e, 0xfe, 0x98, 0x71, 0x72, 0x42, 0x93, 0x79, 0x4f, 0x1d,
+	0x7f, 0x8c, 0x2e, 0x01, 0x78, 0xd8, 0xed, 0x61, 0x97, 0x0d, 0xd3, 0xe5, 0xc5, 0x8d, 0xd4, 0x75,
+	0x41, 0x93, 0x79, 0x2f, 0x85, 0x5c, 0x80, 0x6c, 0xd3, 0x68, 0x75, 0x1c, 0x97, 0x2b, 0x96, 0x8f,
+	0x07, 0x5d, 0xe8, 0x1c, 0xe4, 0x0c, 0x93, 0x38, 0xae, 0x6e, 0x5b, 0x8a, 0xc4, 0xf4, 0x9e, 0x65,
+	0xed, 0x6d, 0xab, 0xf4, 0xbd, 0x8b, 0x20, 0x87, 0x1c, 0xa2, 0xb7, 0x40, 0xf4, 0x30, 0xf1, 0x75,
+	0xa1, 0x24, 0x09, 0x51, 0xae, 0x63, 0xb2, 0x35, 0xa7, 0x51, 0x18, 0x45, 0x1b, 0x96, 0xa5, 0xa4,
+	0x26, 0xa0, 0x2b, 0x96, 0x45, 0xd1, 0x86, 0x65, 0xa1, 0x35, 0x90, 0x5a, 0x4e, 0x0f, 0x33, 0xfe,
+	0xf2, 0xeb, 0xe7, 0x12, 0xe1, 0x0f, 0x9d, 0x1e, 0xde, 0x9a, 0xd3, 0x18, 0x10, 0xdd, 0x86, 0x8c,
+	0x8b, 0x19, 0x89, 0xc4, 0x48, 0xce, 0x27, 0x92, 0x68, 0x0c, 0xb2, 0x35, 0xa7, 0xf9, 0x60, 0xba,
+	0x0e, 0xb6, 0xec, 0xc0, 0x1d, 0x92, 0xd7, 0x51, 0x2d, 0x9b, 0x4a, 0xc1, 0x80, 0x74, 0x1d, 0x0f,
+	0x37, 0xb1, 0x49, 0x94, 0xcc, 0x84, 0x75, 0xea, 0x0c, 0x42, 0xd7, 0xe1, 0x60, 0xb4, 0x0e, 0x69,
+	0x8f, 0xf4, 0x9b, 0x58, 0xc9, 0x32, 0xaa, 0x62, 0x32, 0x15, 0x45, 0x6c, 0xcd, 0x69, 0x1c, 0x8a,
+	0xee, 0x41, 0xce, 0x6e, 0x9b, 0x2e, 0x36, 0x3c, 0xac, 0xe4, 0x18, 0xd9, 0xc5, 0x44, 0xb2, 0x6d,
+	0x1f, 0xb4, 0x35, 0xa7, 0x85, 0x04, 0xe8, 0x0e, 0xe4, 0x3c, 0x4c, 0x74, 0xe2, 0x62, 0xac, 0xc8,
+	0x8c, 0xf8, 0xc2, 0x38, 0x0b, 0x35, 0x5c, 0x4c, 0x69, 0xb3, 0x1e, 0xff, 0x44, 0x2a, 0xcc, 0x73,
+	0xed, 0xe8, 0x9c, 0x65, 0x60, 0xe4, 0x2b, 0x13, 0x14, 0x1a, 0x30, 0x9e, 0x77, 0x07, 0xcd, 0xe2,
+	0xaf, 0x05, 0x10, 0xeb, 0x98, 0xd0, 0xed, 0xd7, 0x31, 0x5c, 0xea, 0xaf, 0x94, 0x35, 0x82, 0x2d,
+	0xdd, 0x08, 0x9c, 0x66, 0xdc, 0xf6, 0xe3, 0xf8, 0x2a, 0x87, 0x57, 0x08, 0x2a, 0x80, 0x48, 0x63,
+	0x0b, 0xdf, 0x4b, 0xf4, 0x93, 0xea, 0xb3, 0x67, 0x34, 0xbb, 0x81, 0x83, 0x44, 0x65, 0x7b, 0x50,
+	0xdf, 0xad, 0xa9, 0x4d, 0x4c, 0xa3, 0x4f, 0xdd, 0x6e, 0x75, 0x9a, 0x58, 0xe3, 0x50, 0xf4, 0x0e,
+	0xe4, 0xf1, 0x33, 0x6c, 0x76, 0x7d, 0x16, 0xa4, 0x49, 0x2c, 0x40, 0x80, 0xac, 0x90, 0xe2, 0xbf,
+	0x05, 0x10, 0x2b, 0x96, 0xf5, 0x32, 0x04, 0x79, 0x0f, 0x16, 0x3a, 0x2e, 0xee, 0x45, 0x27, 0x48,
+	0x4d, 0x9a, 0xe0, 0x04, 0x45, 0x0f, 0xc8, 0xff, 0x9f, 0x52, 0xff, 0x47, 0x00, 0x89, 0xee, 0xb0,
+	0xd7, 0x40, 0xec, 0x5b, 0x00, 0x11, 0x4a, 0x71, 0x12, 0xa5, 0x6c, 0x86, 0x54, 0xb3, 0x0a, 0xfe,
+	0xa9, 0x00, 0x19, 0xee, 0xd6, 0x2f, 0x43, 0xf4, 0x61, 0xde, 0x53, 0xb3, 0xf1, 0x2e, 0x4e, 0xcb,
+	0xfb, 0x3f, 0x24, 0x90, 0x68, 0xb8, 0x7a, 0x19, 0x9c, 0x5f, 0x05, 0xe9, 0x89, 0xeb, 0xb4, 0x7c,
+	0x9e, 0x97, 0xa2, 0x54, 0xf8, 0x19, 0xa9, 0x39, 0x16, 0xde, 0x73, 0x3c, 0x8d, 0x61, 0xd0, 0x15,
+	0x48, 0x11, 0x47, 0x11, 0x27, 0x22, 0x53, 0xc4, 0x41, 0x87, 0x70, 0x76, 0xc0, 0x8f, 0xde, 0x32,
+	0x3a, 0xfa, 0x41, 0x5f, 0x67, 0xa7, 0x8b, 0x7f, 0x8e, 0xaf, 0x8f, 0x8d, 0xc0, 0xe5, 0x90, 0xb3,
+	0x87, 0x46, 0x67, 0xa3, 0x5f, 0xa1, 0x44, 0x6a, 0x9b, 0xb8, 0x7d, 0x6d, 0xd1, 0x1c, 0x1d, 0xa1,
+	0x47, 0xb0, 0xe9, 0xb4, 0x09, 0x6e, 0xf3, 0xd8, 0x2e, 0x6b, 0x41, 0x33, 0xae, 0xdb, 0xcc, 0x94,
+	0xba, 0x45, 0xdb, 0x00, 0x06, 0x21, 0xae, 0x7d, 0xd0, 0x25, 0xd8, 0x53, 0xb2, 0x8c, 0xdd, 0x37,
+	0xc7, 0xb3, 0x5b, 0x09, 0xb1, 0x9c, 0xcb, 0x08, 0x31, 0xba, 0x0a, 0x69, 0xdc, 0x3a, 0xc0, 0x96,
+	0x1f, 0xd6, 0x4f, 0xc7, 0x34, 0xa6, 0xd2, 0x31, 0x8d, 0x43, 0x8a, 0xdf, 0x06, 0x65, 0x9c, 0xe4,
+	0x41, 0x5c, 0x14, 0x06, 0x71, 0xf1, 0x5a, 0x10, 0x21, 0x26, 0x7a, 0x1a, 0xc7, 0xdc, 0x4d, 0x7d,
+	0x55, 0x28, 0xbe, 0x07, 0x0b, 0x31, 0x4e, 0x13, 0x66, 0x3d, 0x1d, 0x9d, 0x55, 0x8e, 0x92, 0xff,
+	0x51, 0x80, 0x0c, 0x3f, 0xec, 0x5e, 0x57, 0x97, 0x9b, 0x35, 0x0c, 0xfc, 0x42, 0x82, 0x34, 0x3b,
+	0xc8, 0x5e, 0x57, 0xc1, 0x1e, 0x0c, 0xf9, 0x23, 0xdf, 0x3e, 0x57, 0xc7, 0xe7, 0x15, 0x13, 0x1d,
+	0x32, 0xa6, 0xa4, 0xf4, 0xb4, 0x7b, 0xc2, 0x1e, 0xbf, 0x9f, 0x33, 0x8c, 0xa1, 0x9b, 0x13, 0x18,
+	0x3a, 0xd6, 0x86, 0xfe, 0xac, 0x8e, 0xfa, 0x8a, 0xb7, 0xd1, 0x9f, 0x44, 0xc8, 0x47, 0x72, 0xa1,
+	0xd7, 0xd5, 0x67, 0xae, 0xc3, 0xe9, 0x81, 0xd5, 0x75, 0xe2, 0xe8, 0x61, 0xce, 0x2c, 0xae, 0xca,
+	0x1a, 0x1a, 0x8c, 0x35, 0x1c, 0xff, 0x08, 0x9c, 0xd5, 0x33, 0x9c, 0x17, 0x79, 0xc6, 0x9d, 0x17,
+	0xe5, 0x93, 0xc7, 0xf4, 0x8f, 0x57, 0x6c, 0xe0, 0x4f, 0x05, 0xc8, 0x05, 0x89, 0xf6, 0xcb, 0xb0,
+	0xee, 0xfa, 0x30, 0x03, 0xb3, 0xa4, 0x72, 0x53, 0x67, 0x05, 0xbf, 0x12, 0x20, 0xeb, 0xe7, 0xf9,
+	0xaf, 0x26, 0x1b, 0x7f, 0x6b, 0x38, 0x2f, 0x5d, 0x4a, 0x16, 0xe6, 0x33, 0x66, 0xa4, 0x1b, 0x19,
+	0x90, 0x0e, 0x1c, 0xab, 0x5f, 0xfa, 0xa7, 0x00, 0xa7, 0x46, 0x74, 0x14, 0x4b, 0xb4, 0x84, 0x29,
+	0x13, 0xad, 0xeb, 0x90, 0xa3, 0x0e, 0xf7, 0xe2, 0xe4, 0x2c, 0xcb, 0x60, 0x3c, 0xa1, 0x73, 0x71,
+	0x48, 0x33, 0x39, 0x19, 0xf5, 0x81, 0x15, 0x82, 0x56, 0x41, 0x22, 0xfd, 0x0e, 0x2f, 0x4e, 0x4f,
+	0x0e, 0x1d, 0xf8, 0x8f, 0xa8, 0x4e, 0x1a, 0xfd, 0x0e, 0xd6, 0x18, 0x62, 0x10, 0xc2, 0xd2, 0xac,
+	0xf6, 0xe6, 0x8d, 0xd2, 0xcf, 0xe6, 0x21, 0x1f, 0x91, 0x19, 0x6d, 0x42, 0xfe, 0x23, 0xcf, 0x69,
+	0xeb, 0xce, 0xc1, 0x47, 0xd8, 0x0c, 0xc4, 0xbd, 0x94, 0xac, 0x77, 0xf6, 0xbd, 0xcb, 0x80, 0x5b,
+	0x73, 0x1a, 0x50, 0x3a, 0xde, 0x42, 0x15, 0x60, 0x2d, 0xdd, 0x70, 0x5d, 0xa3, 0xaf, 0xa4, 0x46,
+	0xea, 0xbc, 0xf8, 0x24, 0x15, 0x8a, 0xdb, 0x9a, 0xd3, 0x64, 0x4a, 0xc5, 0x1a, 0xe8, 0xeb, 0x20,
+	0x77, 0x5c, 0xbb, 0x65, 0x13, 0x3b, 0xac, 0xd6, 0xc7, 0xcd, 0xb0, 0x17, 0xe0, 0xe8, 0x0c, 0x21,
+	0x11, 0xba, 0x01, 0x12, 0xc1, 0xcf, 0x82, 0xd0, 0x72, 0x7e, 0x0c, 0x31, 0x8d, 0x64, 0xb4, 0x08,
+	0xa7, 0x50, 0x74, 0x97, 0x26, 0x77, 0xdd, 0x36, 0xc1, 0xae, 0x9f, 0xbe, 0x2d, 0x8f, 0xa1, 0xaa,
+	0x72, 0x14, 0xad, 0x6e, 0x7d, 0x02, 0xf4, 0x2e, 0x64, 0xcc, 0xae, 0x47, 0x9c, 0x96, 0x92, 0x1d,
+	0xa9, 0xa9, 0x87, 0x48, 0x19, 0x88, 0x96, 0xf0, 0x1c, 0x5e, 0xfc, 0x83, 0x00, 0x30, 0xd0, 0x24,
+	0x5a, 0x85, 0x74, 0xdb, 0xb1, 0xb0, 0xa7, 0x08, 0x2c, 0x9c, 0xa1, 0xc8, 0x34, 0xda, 0x56, 0x83,
+	0x06, 0x5d, 0x8d, 0x03, 0x66, 0x2c, 0x01, 0xa2, 0x9e, 0x29, 0xce, 0xe0, 0x99, 0xd2, 0x74, 0x9e,
+	0x59, 0xfc, 0xbd, 0x00, 0x72, 0x68, 0xdb, 0x89, 0x52, 0xdd, 0xaf, 0x7c, 0x7e, 0xa4, 0xfa, 0x9b,
+	0x00, 0x72, 0xe8, 0x6f, 0xe1, 0xee, 0x13, 0xa6, 0xdf, 0x7d, 0xa9, 0xc8, 0xee, 0x9b, 0xb1, 0x00,
+	0x8d, 0xca, 0x2a, 0xcd, 0x20, 0x6b, 0x7a, 0x4a, 0x59, 0xff, 0x2e, 0x80, 0x44, 0xb7, 0x07, 0x7a,
+	0x73, 0xd8, 0x78, 0x8b, 0x09, 0x89, 0xc0, 0xe7, 0xc2, 0x7a, 0xe8, 0x3c, 0xc8, 0xc1, 0x2f, 0x48,
+	0x4f, 0x49, 0xaf, 0x88, 0xf4, 0xdf, 0xaf, 0xff, 0x0f, 0xd2, 0x2b, 0xfe, 0x55, 0x80, 0xac, 0xbf,
+	0xaf, 0xbf, 0xe0, 0x86, 0x5d, 0x86, 0x0c, 0x8f, 0x42, 0x03, 0xee, 0x85, 0x08, 0xf7, 0xe1, 0x81,
+	0xf8, 0x10, 0xb2, 0x7e, 0xc8, 0x49, 0xc8, 0x74, 0xae, 0x43, 0x16, 0xf3, 0x90, 0x96, 0x90, 0x49,
+	0x46, 0x4f, 0xe7, 0x00, 0x56, 0x32, 0x21, 0xeb, 0xef, 0x75, 0x74, 0x05, 0xa4, 0x36, 0x8d, 0xcd,
+	0xfc, 0x7c, 0x49, 0x8a, 0x06, 0x6c, 0x7c, 0x86, 0x45, 0x7e, 0x22, 0xc0, 0x7c, 0xe0, 0x94, 0x34,
+	0xaf, 0x1f, 0x16, 0x51, 0x8e, 0x18, 0xa8, 0xdb, 0xb1, 0xa6, 0xf3, 0x53, 0x1f, 0x58, 0x21, 0xe8,
+	0x26, 0x00, 0x23, 0xd7, 0x99, 0x73, 0x88, 0x13, 0x9c, 0x43, 0xee, 0x05, 0x9f, 0xa5, 0xdf, 0x8a,
+	0x90, 0x0b, 0x38, 0x42, 0x5f, 0x8e, 0xfc, 0xe6, 0x3f, 0x93, 0xb0, 0x8f, 0xfc, 0x1f, 0xfd, 0x89,
+	0xf5, 0xc6, 0x8c, 0x29, 0xc2, 0x6d, 0xc8, 0xdb, 0x6d, 0x4f, 0x67, 0x3f, 0xca, 0xfc, 0x5f, 0xef,
+	0x63, 0xd7, 0x96, 0xed, 0xb6, 0xb7, 0xe7, 0xe2, 0xde, 0xb6, 0x85, 0xaa, 0x43, 0x65, 0x60, 0x9a,
+	0xed, 0xfc, 0xcb, 0x09, 0x54, 0xd3, 0xfd, 0x90, 0xc8, 0xbc, 0xf0, 0x87, 0x04, 0xbb, 0x7f, 0x70,
+	0x5a, 0x1d, 0xc3, 0x24, 0x94, 0xcd, 0x2c, 0x73, 0x48, 0xd9, 0xef, 0xd9, 0xb6, 0xd0, 0xdb, 0xb0,
+	0x18, 0x0e, 0x47, 0xc4, 0xc9, 0x31, 0x5c, 0x21, 0xc0, 0x05, 0xec, 0x17, 0x1f, 0x4d, 0x53, 0xd6,
+	0xbd, 0x3d, 0x9c, 0x2c, 0x9f, 0x4d, 0x10, 0x8f, 0x4e, 0x12, 0xc9, 0xd7, 0x4b, 0xdf, 0x17, 0x40,
+	0x0e, 0x59, 0x47, 0xf7, 0x20, 0xdb, 0x31, 0xfa, 0x4d, 0xc7, 0xb0, 0xfc, 0xd8, 0x78, 0x29, 0x49,
+	0xc2, 0xf2, 0x1e, 0xc7, 0x70, 0xfd, 0x04, 0x14, 0xc5, 0xbb, 0x30, 0x1f, 0x1d, 0x38, 0x4e, 0xd9,
+	0x59, 0xfa, 0x10, 0x60, 0x60, 0xb6, 0x19, 0x73, 0xd4, 0x25, 0xc8, 0x38, 0x4f, 0x9e, 0xd0, 0xab,
+	0x16, 0x3a, 0x7d, 0x5a, 0xf3, 0x5b, 0xa5, 0x16, 0x48, 0xfb, 0x1e, 0x76, 0xd1, 0xc9, 0xd0, 0x57,
+	0x65, 0xe6, 0x94, 0x45, 0xc8, 0x75, 0x3d, 0xec, 0xb6, 0x8d, 0x56, 0xc0, 0x50, 0xd8, 0x46, 0x77,
+	0x12, 0x02, 0x5e, 0xb1, 0xcc, 0xaf, 0xfc, 0xca, 0xc1, 0x95, 0x5f, 0xb9, 0x11, 0xdc, 0x09, 0x46,
+	0xd8, 0x28, 0xfd, 0x37, 0x05, 0xd9, 0x3d, 0xd7, 0x61, 0xc9, 0x4f, 0x7c, 0x49, 0x04, 0x52, 0x64,
+	0x39, 0xf6, 0x4d, 0xfd, 0xa4, 0xd3, 0x3d, 0x68, 0xda, 0x26, 0xbb, 0x17, 0x14, 0xd9, 0x88, 0xcc,
+	0x7b, 0xe8, 0xad, 0xe0, 0x45, 0x7a, 0x4f, 0x65, 0xba, 0x98, 0x5f, 0x1b, 0x4a, 0x7c, 0x98, 0xf7,
+	0xd0, 0xe1, 0x55, 0x28, 0x18, 0x5d, 0x72, 0xa8, 0x7f, 0x82, 0x0f, 0x0e, 0x1d, 0xe7, 0x48, 0xef,
+	0xba, 0x4d, 0xff, 0x47, 0xde, 0x49, 0xda, 0xff, 0x01, 0xef, 0xde, 0x77, 0x9b, 0xac, 0xa6, 0x8d,
+	0x22, 0x5b, 0x98, 0x1c, 0x3a, 0x96, 0xa7, 0x64, 0xfc, 0x9a, 0x76, 0x80, 0x7e, 0xc8, 0x47, 0xd0,
+	0xd7, 0xe0, 0xbc, 0x7f, 0x83, 0x66, 0x61, 0xc3, 0x24, 0x76, 0xcf, 0x20, 0x58, 0x27, 0x87, 0x2e,
+	0xf6, 0x0e, 0x9d, 0x26, 0x77, 0x69, 0x59, 0x3b, 0xc7, 0x21, 0x9b, 0x21, 0xa2, 0x11, 0x00, 0x62,
+	0x4a, 0xcc, 0x1d, 0x43, 0x89, 0x94, 0x34, 0x12, 0xcf, 0xe4, 0x17, 0x93, 0x86, 0x41, 0xad, 0xf4,
+	0x43, 0x11, 0x96, 0xf6, 0x69, 0xcb, 0x38, 0x68, 0x62, 0xdf, 0x10, 0xef, 0xdb, 0xb8, 0x69, 0x79,
+	0xe8, 0xba, 0xaf, 0x7e, 0xc1, 0xaf, 0x25, 0xe3, 0xf3, 0xd5, 0x89, 0x6b, 0xb7, 0x9f, 0xb2, 0xa8,
+	0xe7, 0x1b, 0xe7, 0xfd, 0x04, 0xf5, 0xa6, 0xa6, 0xa0, 0x8e, 0x2b, 0xff, 0xc9, 0x18, 0xe5, 0x73,
+	0xcf, 0xba, 0x15, 0xf1, 0xed, 0x64, 0xd6, 0xcb, 0x95, 0x11, 0xf3, 0x24, 0x9a, 0xec, 0x5b, 0x93,
+	0x4d, 0x26, 0x4d, 0xc1, 0xfa, 0x78, 0x83, 0x16, 0xcb, 0x80, 0x46, 0xf9, 0xe0, 0xb7, 0xb8, 0x5c,
+	0x1c, 0x81, 0xf9, 0x52, 0xd0, 0x2c, 0x7d, 0x37, 0x05, 0x0b, 0x9b, 0xfe, 0x0d, 0x77, 0xbd, 0xdb,
+	0x6a, 0x19, 0x6e, 0x7f, 0x64, 0x4b, 0x8c, 0x56, 0xc9, 0xf1, 0x0b, 0x6d, 0x39, 0x72, 0xa1, 0x3d,
+	0xec, 0x52, 0xd2, 0x71, 0x5c, 0xea, 0x1e, 0xe4, 0x0d, 0xd3, 0xc4, 0x9e, 0x17, 0x4d, 0x2e, 0x26,
+	0xd1, 0x42, 0x00, 0x1f, 0xf1, 0xc7, 0xcc, 0x71, 0xfc, 0xf1, 0x47, 0x02, 0xe4, 0xf6, 0x5c, 0xec,
+	0xe1, 0xb6, 0xc9, 0xd2, 0x2b, 0xb3, 0xe9, 0x98, 0x47, 0x4c, 0x01, 0x69, 0x8d, 0x37, 0x68, 0x69,
+	0x47, 0x8d, 0xae, 0xa4, 0x56, 0xc4, 0x58, 0xa5, 0x15, 0x10, 0x96, 0x37, 0x0d, 0x62, 0xf0, 0x78,
+	0xcb, 0xa0, 0xc5, 0x77, 0x41, 0x0e, 0xbb, 0x8e, 0x15, 0x69, 0xb7, 0x21, 0x53, 0x65, 0x06, 0x8e,
+	0x58, 0x62, 0x9e, 0x59, 0x62, 0x0d, 0x72, 0x1d, 0x7f, 0x39, 0xdf, 0xc7, 0x17, 0x13, 0x38, 0xd1,
+	0x42, 0x50, 0xe9, 0x1d, 0xc8, 0xf2, 0xa9, 0x3c, 0xf6, 0xd0, 0x80, 0x7f, 0x2a, 0xc2, 0xe8, 0x43,
+	0x03, 0x36, 0xa2, 0x05, 0x88, 0x52, 0x8d, 0xbe, 0x8c, 0x08, 0xdf, 0x2f, 0x0c, 0x5f, 0xc4, 0x0b,
+	0x49, 0x17, 0xf1, 0xc3, 0x57, 0xf9, 0xa9, 0xd8, 0x55, 0x3e, 0x3d, 0xc3, 0xf2, 0x91, 0x3f, 0x78,
+	0x2f, 0xf7, 0xf8, 0x40, 0x5f, 0x81, 0x05, 0x17, 0x37, 0x0d, 0x62, 0xf7, 0xb0, 0xee, 0x03, 0x44,
+	0x06, 0x38, 0x19, 0x74, 0xef, 0xf2, 0x73, 0xc6, 0x04, 0x18, 0xcc, 0x1c, 0x7d, 0x3c, 0x20, 0x8c,
+	0x3e, 0x1e, 0xb8, 0x00, 0xb2, 0x85, 0x9b, 0xb4, 0xee, 0xc2, 0x6e, 0x20, 0x50, 0xd8, 0x31, 0xf4,
+	0xb4, 0x40, 0x1c, 0x7e, 0x5a, 0xf0, 0x63, 0x01, 0x72, 0x9b, 0x8e, 0xa9, 0xf6, 0xa8, 0x05, 0xaf,
+	0x0d, 0xa5, 0xf5, 0xd1, 0xe3, 0x3e, 0x80, 0x44, 0x32, 0xfb, 0x35, 0xe0, 0xa7, 0x8a, 0x77, 0xe8,
+	0x2f, 0x99, 0x68, 0xa4, 0x01, 0x06, 0x5d, 0x86, 0x13, 0xd1, 0x27, 0x2b, 0xfc, 0x19, 0x86, 0xac,
+	0xcd, 0x47, 0xde, 0xac, 0x78, 0x57, 0x7f, 0x99, 0x02, 0x39, 0x4c, 0x13, 0xd1, 0x22, 0x2c, 0x3c,
+	0xaa, 0xec, 0xec, 0xab, 0x7a, 0xe3, 0xf1, 0x9e, 0xaa, 0xd7, 0xf6, 0x77, 0x76, 0x0a, 0x73, 0x68,
+	0x09, 0x50, 0xa4, 0x73, 0x63, 0x77, 0x77, 0x47, 0xad, 0xd4, 0x0a, 0x42, 0xac, 0x7f, 0xbb, 0xd6,
+	0x50, 0xef, 0xab, 0x5a, 0x21, 0x15, 0x9b, 0x64, 0x67, 0xb7, 0x76, 0xbf, 0x20, 0xa2, 0x33, 0x70,
+	0x2a, 0xd2, 0xb9, 0xb9, 0xbb, 0xbf, 0xb1, 0xa3, 0x16, 0xa4, 0x58, 0x77, 0xbd, 0xa1, 0x6d, 0xd7,
+	0xee, 0x17, 0xd2, 0xe8, 0x34, 0x14, 0xa2, 0x4b, 0x3e, 0x6e, 0xa8, 0xf5, 0x42, 0x26, 0x36, 0xf1,
+	0x66, 0xa5, 0xa1, 0x16, 0xb2, 0xa8, 0x08, 0x4b, 0x91, 0x4e, 0x9a, 0x83, 0xeb, 0xbb, 0x1b, 0x0f,
+	0xd4, 0x6a, 0xa3, 0x90, 0x43, 0xe7, 0xe0, 0x4c, 0x7c, 0xac, 0xa2, 0x69, 0x95, 0xc7, 0x05, 0x39,
+	0x36, 0x57, 0x43, 0xfd, 0x66, 0xa3, 0x00, 0xb1, 0xb9, 0x7c, 0x89, 0xf4, 0x6a, 0xad, 0x51, 0xc8,
+	0xa3, 0xb3, 0xb0, 0x18, 0x93, 0x8a, 0x0d, 0xcc, 0x5f, 0xfd, 0xb9, 0x00, 0xf3, 0x51, 0x73, 0xa1,
+	0x2f, 0xc1, 0xca, 0xe6, 0x6e, 0x55, 0x57, 0x1f, 0xa9, 0xb5, 0x46, 0x20, 0x6e, 0x75, 0xff, 0xa1,
+	0x5a, 0x6b, 0xd4, 0xf5, 0xea, 0x56, 0xa5, 0x76, 0x5f, 0xdd, 0x2c, 0xcc, 0x4d, 0x44, 0x7d, 0x50,
+	0x69, 0x54, 0xb7, 0xd4, 0xcd, 0x82, 0x80, 0xae, 0x40, 0x69, 0x2c, 0x6a, 0xbf, 0x16, 0xe0, 0x52,
+	0xe8, 0x32, 0xbc, 0x11, 0xc3, 0xed, 0x69, 0x6a, 0x5d, 0xad, 0x55, 0xd5, 0x70, 0x49, 0x71, 0xe3,
+	0xda, 0x6f, 0x9e, 0x2f, 0x0b, 0xbf, 0x7b, 0xbe, 0x2c, 0xfc, 0xf9, 0xf9, 0xb2, 0xf0, 0xd3, 0xbf,
+	0x2c, 0xcf, 0xc1, 0x29, 0x0b, 0xf7, 0x02, 0x1f, 0x32, 0x3a, 0x76, 0xb9, 0x77, 0x63, 0x4f, 0xf8,
+	0x50, 0x2a, 0xdf, 0xeb, 0xdd, 0x38, 0xc8, 0xb0, 0xa8, 0x78, 0xf3, 0x7f, 0x03, 0x00, 0xf4, 0x40,
+	0xd8, 0x14, 0x6f, 0x25, 0x00, 0x00,
 }
 
 func (m *ChangePack) Marshal() (dAtA []byte, err error) {
@@ -3320,6 +3692,48 @@ func (m *Operation_Increase_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Operation_SetTree_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_SetTree_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetTree != nil {
+		{
+			size, err := m.SetTree.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_RemoveStyle_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_RemoveStyle_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RemoveStyle != nil {
+		{
+			size, err := m.RemoveStyle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Operation_Set) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3627,6 +4041,18 @@ func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Embed != nil {
+		{
+			size, err := m.Embed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Attributes) > 0 {
 		for k := range m.Attributes {
 			v := m.Attributes[k]
@@ -3829,6 +4255,32 @@ func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.ExecutedAt != nil {
 		{
 			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -3899,6 +4351,116 @@ func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Operation_RemoveStyle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_RemoveStyle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_RemoveStyle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AttributesToRemove) > 0 {
+		for iNdEx := len(m.AttributesToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AttributesToRemove[iNdEx])
+			copy(dAtA[i:], m.AttributesToRemove[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.AttributesToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Operation_Increase) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3962,6 +4524,76 @@ func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Operation_SetTree) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_SetTree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_SetTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *JSONElementSimple) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4178,6 +4810,27 @@ func (m *JSONElement_Counter_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *JSONElement_Custom_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Custom_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Custom != nil {
+		{
+			size, err := m.Custom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4431,6 +5084,15 @@ func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ActorIds) > 0 {
+		for iNdEx := len(m.ActorIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActorIds[iNdEx])
+			copy(dAtA[i:], m.ActorIds[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.ActorIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.RemovedAt != nil {
 		{
 			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -4559,6 +5221,40 @@ func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JSONElement_Custom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement_Custom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Custom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RHTNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4680,6 +5376,11 @@ func (m *TextNodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ValueType != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ValueType))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.UpdatedAt != nil {
 		{
 			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -4726,6 +5427,32 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.CompactInsPrevId) > 0 {
+		i -= len(m.CompactInsPrevId)
+		copy(dAtA[i:], m.CompactInsPrevId)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.CompactInsPrevId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CompactId) > 0 {
+		i -= len(m.CompactId)
+		copy(dAtA[i:], m.CompactId)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.CompactId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Embed != nil {
+		{
+			size, err := m.Embed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Attributes) > 0 {
 		for k := range m.Attributes {
 			v := m.Attributes[k]
@@ -4798,6 +5525,52 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TextEmbed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextEmbed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextEmbed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Payload) > 0 {
+		for k := range m.Payload {
+			v := m.Payload[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5720,6 +6493,30 @@ func (m *Operation_Increase_) Size() (n int) {
 	}
 	return n
 }
+func (m *Operation_SetTree_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetTree != nil {
+		l = m.SetTree.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_RemoveStyle_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemoveStyle != nil {
+		l = m.RemoveStyle.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
 func (m *Operation_Set) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5875,6 +6672,10 @@ func (m *Operation_Edit) Size() (n int) {
 			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
 		}
 	}
+	if m.Embed != nil {
+		l = m.Embed.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5939,6 +6740,66 @@ func (m *Operation_Style) Size() (n int) {
 		l = m.ExecutedAt.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_RemoveStyle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.AttributesToRemove) > 0 {
+		for _, s := range m.AttributesToRemove {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5969,6 +6830,34 @@ func (m *Operation_Increase) Size() (n int) {
 	return n
 }
 
+func (m *Operation_SetTree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *JSONElementSimple) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6075,6 +6964,18 @@ func (m *JSONElement_Counter_) Size() (n int) {
 	}
 	return n
 }
+func (m *JSONElement_Custom_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Custom != nil {
+		l = m.Custom.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
 func (m *JSONElement_JSONObject) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6190,6 +7091,12 @@ func (m *JSONElement_Text) Size() (n int) {
 		l = m.RemovedAt.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
+	if len(m.ActorIds) > 0 {
+		for _, b := range m.ActorIds {
+			l = len(b)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6227,6 +7134,22 @@ func (m *JSONElement_Counter) Size() (n int) {
 	return n
 }
 
+func (m *JSONElement_Custom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RHTNode) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6281,6 +7204,9 @@ func (m *TextNodeAttr) Size() (n int) {
 		l = m.UpdatedAt.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
+	if m.ValueType != 0 {
+		n += 1 + sovResources(uint64(m.ValueType))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6322,6 +7248,38 @@ func (m *TextNode) Size() (n int) {
 			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
 		}
 	}
+	if m.Embed != nil {
+		l = m.Embed.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.CompactId)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.CompactInsPrevId)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TextEmbed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		for k, v := range m.Payload {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7484,11 +8442,81 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			}
 			m.Body = &Operation_Increase_{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetTree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_SetTree{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_SetTree_{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveStyle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_RemoveStyle{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_RemoveStyle_{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthResources
@@ -8707,6 +9735,42 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 			}
 			m.Attributes[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Embed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Embed == nil {
+				m.Embed = &TextEmbed{}
+			}
+			if err := m.Embed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -9224,6 +10288,135 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAtMapByActor == nil {
+				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
+			}
+			var mapkey string
+			var mapvalue *TimeTicket
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TimeTicket{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CreatedAtMapByActor[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -9246,7 +10439,7 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
+func (m *Operation_RemoveStyle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9269,10 +10462,10 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Increase: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveStyle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Increase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveStyle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9313,7 +10506,7 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9340,16 +10533,16 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &JSONElementSimple{}
+			if m.From == nil {
+				m.From = &TextNodePos{}
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9376,69 +10569,18 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.To == nil {
+				m.To = &TextNodePos{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributesToRemove", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JSONElementSimple: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONElementSimple: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9448,31 +10590,27 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AttributesToRemove = append(m.AttributesToRemove, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9499,16 +10637,16 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
 			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9535,65 +10673,105 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
-			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= ValueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if m.CreatedAtMapByActor == nil {
+				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *TimeTicket
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TimeTicket{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.CreatedAtMapByActor[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9617,7 +10795,7 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement) Unmarshal(dAtA []byte) error {
+func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9640,15 +10818,15 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JSONElement: wiretype end group for non-group")
+			return fmt.Errorf("proto: Increase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONElement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Increase: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsonObject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9675,15 +10853,16 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_JSONObject{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_JsonObject{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsonArray", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9710,15 +10889,16 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_JSONArray{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Value == nil {
+				m.Value = &JSONElementSimple{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_JsonArray{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Primitive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9745,81 +10925,12 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_Primitive{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &JSONElement_Primitive_{v}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JSONElement_Text{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &JSONElement_Text_{v}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
 			}
-			v := &JSONElement_Counter{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_Counter_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9843,7 +10954,7 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
+func (m *Operation_SetTree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9866,15 +10977,15 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JSONObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetTree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetTree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9901,16 +11012,18 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &RHTNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9920,31 +11033,27 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9971,16 +11080,16 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
+			if m.Value == nil {
+				m.Value = &JSONElement{}
 			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10007,10 +11116,10 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
 			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10036,7 +11145,7 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
+func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10059,15 +11168,15 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JSONArray: wiretype end group for non-group")
+			return fmt.Errorf("proto: JSONElementSimple: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONArray: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JSONElementSimple: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10094,14 +11203,16 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &RGANode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10128,16 +11239,16 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10164,18 +11275,37 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
 			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -10185,26 +11315,24 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
-			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10229,7 +11357,7 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
+func (m *JSONElement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10252,17 +11380,17 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Primitive: wiretype end group for non-group")
+			return fmt.Errorf("proto: JSONElement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Primitive: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JSONElement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonObject", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -10272,16 +11400,32 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ValueType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JSONElement_JSONObject{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &JSONElement_JsonObject{v}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonArray", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -10291,29 +11435,30 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			v := &JSONElement_JSONArray{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Body = &JSONElement_JsonArray{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Primitive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10340,16 +11485,15 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &JSONElement_Primitive{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Body = &JSONElement_Primitive_{v}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10376,16 +11520,15 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
-			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &JSONElement_Text{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Body = &JSONElement_Text_{v}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10412,12 +11555,46 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+			v := &JSONElement_Counter{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Body = &JSONElement_Counter_{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Custom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JSONElement_Custom{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Body = &JSONElement_Custom_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10441,7 +11618,7 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10464,10 +11641,10 @@ func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Text: wiretype end group for non-group")
+			return fmt.Errorf("proto: JSONObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Text: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JSONObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10499,7 +11676,7 @@ func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &TextNode{})
+			m.Nodes = append(m.Nodes, &RHTNode{})
 			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -10634,7 +11811,7 @@ func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10657,36 +11834,17 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+			return fmt.Errorf("proto: JSONArray: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JSONArray: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= ValueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -10696,27 +11854,27 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Nodes = append(m.Nodes, &RGANode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
@@ -10752,7 +11910,7 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
@@ -10788,7 +11946,7 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
@@ -10846,7 +12004,7 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RHTNode) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10869,17 +12027,36 @@ func (m *RHTNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RHTNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: Primitive: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RHTNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Primitive: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -10889,27 +12066,29 @@ func (m *RHTNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10936,10 +12115,82 @@ func (m *RHTNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Element == nil {
-				m.Element = &JSONElement{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
 			}
-			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10965,7 +12216,7 @@ func (m *RHTNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RGANode) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10988,15 +12239,15 @@ func (m *RGANode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RGANode: wiretype end group for non-group")
+			return fmt.Errorf("proto: Text: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RGANode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Text: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11023,16 +12274,1151 @@ func (m *RGANode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Next == nil {
-				m.Next = &RGANode{}
-			}
-			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, &TextNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorIds = append(m.ActorIds, make([]byte, postIndex-iNdEx))
+			copy(m.ActorIds[len(m.ActorIds)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JSONElement_Custom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Custom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Custom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RHTNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RHTNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RHTNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Element == nil {
+				m.Element = &JSONElement{}
+			}
+			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RGANode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RGANode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RGANode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Next == nil {
+				m.Next = &RGANode{}
+			}
+			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Element == nil {
+				m.Element = &JSONElement{}
+			}
+			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TextNodeAttr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextNodeAttr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextNodeAttr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &TimeTicket{}
+			}
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			m.ValueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueType |= ValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TextNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &TextNodeID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsPrevId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InsPrevId == nil {
+				m.InsPrevId = &TextNodeID{}
+			}
+			if err := m.InsPrevId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]*TextNodeAttr)
+			}
+			var mapkey string
+			var mapvalue *TextNodeAttr
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TextNodeAttr{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Embed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11059,69 +13445,18 @@ func (m *RGANode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Element == nil {
-				m.Element = &JSONElement{}
+			if m.Embed == nil {
+				m.Embed = &TextEmbed{}
 			}
-			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Embed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TextNodeAttr) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TextNodeAttr: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextNodeAttr: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11131,29 +13466,31 @@ func (m *TextNodeAttr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.CompactId = append(m.CompactId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompactId == nil {
+				m.CompactId = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactInsPrevId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11163,26 +13500,24 @@ func (m *TextNodeAttr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = &TimeTicket{}
-			}
-			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CompactInsPrevId = append(m.CompactInsPrevId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompactInsPrevId == nil {
+				m.CompactInsPrevId = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -11207,7 +13542,7 @@ func (m *TextNodeAttr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TextNode) Unmarshal(dAtA []byte) error {
+func (m *TextEmbed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11230,155 +13565,15 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TextNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: TextEmbed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TextEmbed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Id == nil {
-				m.Id = &TextNodeID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
-			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsPrevId", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InsPrevId == nil {
-				m.InsPrevId = &TextNodeID{}
-			}
-			if err := m.InsPrevId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11405,11 +13600,11 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]*TextNodeAttr)
+			if m.Payload == nil {
+				m.Payload = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue *TextNodeAttr
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -11458,7 +13653,7 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowResources
@@ -11468,26 +13663,24 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthResources
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthResources
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &TextNodeAttr{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipResources(dAtA[iNdEx:])
@@ -11503,7 +13696,7 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Attributes[mapkey] = mapvalue
+			m.Payload[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -265,7 +265,7 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document) error {
 		return err
 	}
 
-	if err := doc.ApplyChangePack(pack); err != nil {
+	if err := doc.ApplyChangePack(ctx, pack); err != nil {
 		return err
 	}
 
@@ -319,7 +319,7 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document) error {
 		return err
 	}
 
-	if err := doc.ApplyChangePack(pack); err != nil {
+	if err := doc.ApplyChangePack(ctx, pack); err != nil {
 		return err
 	}
 
@@ -560,7 +560,7 @@ func (c *Client) sync(ctx context.Context, key key.Key) error {
 		return err
 	}
 
-	if err := attachment.doc.ApplyChangePack(pack); err != nil {
+	if err := attachment.doc.ApplyChangePack(ctx, pack); err != nil {
 		c.logger.Error("failed to apply change pack", zap.Error(err))
 		return err
 	}
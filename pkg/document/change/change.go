@@ -19,6 +19,8 @@
 package change
 
 import (
+	"context"
+
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
@@ -45,16 +47,87 @@ func New(id ID, message string, operations []operations.Operation) *Change {
 	}
 }
 
-// Execute applies this change to the given JSON root.
-func (c *Change) Execute(root *crdt.Root) error {
+// ValidateChanges validates all the operations of the given changes against
+// the given root before any of them is executed, so that an invalid change
+// is never half-applied. The elements created by the preceding operations
+// are registered to the root only while validating, because the following
+// operations can refer to them.
+func ValidateChanges(root *crdt.Root, changes []*Change) error {
+	var pending []crdt.Element
+	defer func() {
+		for _, elem := range pending {
+			root.DeregisterElement(elem)
+		}
+	}()
+
+	for _, c := range changes {
+		if c.hasApplied(root) {
+			continue
+		}
+
+		for _, op := range c.operations {
+			if err := op.Validate(root); err != nil {
+				return err
+			}
+
+			var values []crdt.Element
+			switch op := op.(type) {
+			case *operations.Set:
+				values = append(values, op.Value())
+			case *operations.Add:
+				values = append(values, op.Value())
+			case *operations.SetTree:
+				values = append(values, op.Value())
+				if container, ok := op.Value().(crdt.Container); ok {
+					container.Descendants(func(elem crdt.Element, _ crdt.Container) bool {
+						values = append(values, elem)
+						return false
+					})
+				}
+			}
+			for _, value := range values {
+				if value != nil && root.FindByCreatedAt(value.CreatedAt()) == nil {
+					root.RegisterElement(value)
+					pending = append(pending, value)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Execute applies this change to the given JSON root. If this change has
+// already been applied to the root, it is skipped. If the given context is
+// done, this change is not applied and the error of the context is returned,
+// so that a change is never applied partially.
+func (c *Change) Execute(ctx context.Context, root *crdt.Root) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// NOTE: The changes can be delivered again when the client reconnects.
+	// Skip them because some operations such as Increase are not idempotent.
+	if c.hasApplied(root) {
+		return nil
+	}
+
 	for _, op := range c.operations {
-		if err := op.Execute(root); err != nil {
+		if err := op.Execute(ctx, root); err != nil {
 			return err
 		}
+		root.MarkApplied(op.ExecutedAt())
 	}
 	return nil
 }
 
+// hasApplied returns whether this change has been applied to the given root.
+// The operations of a change are applied together, so it is enough to check
+// the first one.
+func (c *Change) hasApplied(root *crdt.Root) bool {
+	return len(c.operations) > 0 && root.HasApplied(c.operations[0].ExecutedAt())
+}
+
 // ID returns the ID of this change.
 func (c *Change) ID() ID {
 	return c.id
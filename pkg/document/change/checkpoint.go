@@ -114,6 +114,13 @@ func (cp Checkpoint) Forward(other Checkpoint) Checkpoint {
 	return NewCheckpoint(maxServerSeq, maxClientSeq)
 }
 
+// Includes returns whether the change of the given ID has been received by
+// this checkpoint or not. A change that is not stored on the server is not
+// included in any checkpoint.
+func (cp Checkpoint) Includes(id ID) bool {
+	return id.serverSeq != InitialServerSeq && id.serverSeq <= cp.ServerSeq
+}
+
 // Equals returns whether the given checkpoint is equal to this checkpoint or not.
 func (cp *Checkpoint) Equals(other Checkpoint) bool {
 	return cp.ServerSeq == other.ServerSeq &&
@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
 func TestCheckPoint(t *testing.T) {
@@ -51,5 +52,9 @@ func TestCheckPoint(t *testing.T) {
 			cp.Forward(change.NewCheckpoint(5, 30)))
 		assert.Equal(t, change.NewCheckpoint(20, 20),
 			cp.Forward(change.NewCheckpoint(20, 5)))
+
+		assert.True(t, cp.Includes(change.NewID(1, 10, 1, time.InitialActorID)))
+		assert.False(t, cp.Includes(change.NewID(1, 11, 1, time.InitialActorID)))
+		assert.False(t, cp.Includes(change.NewID(1, change.InitialServerSeq, 1, time.InitialActorID)))
 	})
 }
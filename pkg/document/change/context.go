@@ -58,9 +58,10 @@ func (c *Context) HasOperations() bool {
 }
 
 // IssueTimeTicket creates a time ticket to be used to create a new operation.
+// The ticket is issued by the ticket generator of the root.
 func (c *Context) IssueTimeTicket() *time.Ticket {
 	c.delimiter++
-	return c.id.NewTimeTicket(c.delimiter)
+	return c.root.TicketGenerator().Issue(c.id.Lamport(), c.delimiter, c.id.ActorID())
 }
 
 // Push pushes a new operations into context queue.
@@ -68,6 +69,20 @@ func (c *Context) Push(op operations.Operation) {
 	c.operations = append(c.operations, op)
 }
 
+// LastOperation returns the last operation pushed into this context, or nil
+// if there is no operation.
+func (c *Context) LastOperation() operations.Operation {
+	if len(c.operations) == 0 {
+		return nil
+	}
+	return c.operations[len(c.operations)-1]
+}
+
+// Policy returns the policy of the document being modified.
+func (c *Context) Policy() crdt.Policy {
+	return c.root.Policy()
+}
+
 // RegisterElement registers the given element to the root.
 func (c *Context) RegisterElement(elem crdt.Element) {
 	c.root.RegisterElement(elem)
@@ -82,3 +97,8 @@ func (c *Context) RegisterRemovedElementPair(parent crdt.Container, deleted crdt
 func (c *Context) RegisterTextElementWithGarbage(textType crdt.TextElement) {
 	c.root.RegisterTextElementWithGarbage(textType)
 }
+
+// RegisterTextWithSelection register the given text with selections to hash table.
+func (c *Context) RegisterTextWithSelection(text *crdt.Text) {
+	c.root.RegisterTextWithSelection(text)
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+// Anchor is a position in Text that follows the content as the text is
+// edited, such as the boundaries of comments or annotations. Unlike an
+// integer offset, it is keyed on the ID of the node and the relative offset
+// in the node, so it is not affected by the edits of other ranges.
+//
+// An anchor sticks to the character on its left: the content inserted at
+// the anchor is placed after the anchor, and the anchor is invalidated when
+// the character is removed. The anchor at the beginning of the text sticks
+// to the beginning of the text.
+type Anchor struct {
+	id             *RGATreeSplitNodeID
+	relativeOffset int
+}
+
+// NewAnchor creates a new instance of Anchor.
+func NewAnchor(id *RGATreeSplitNodeID, relativeOffset int) Anchor {
+	return Anchor{
+		id:             id,
+		relativeOffset: relativeOffset,
+	}
+}
+
+// ID returns the ID of the node of this anchor.
+func (a Anchor) ID() *RGATreeSplitNodeID {
+	return a.id
+}
+
+// RelativeOffset returns the relative offset of this anchor in the node.
+func (a Anchor) RelativeOffset() int {
+	return a.relativeOffset
+}
+
+// CreateAnchor returns an anchor of the given integer offset.
+func (t *Text) CreateAnchor(offset int) Anchor {
+	pos := t.rgaTreeSplit.findNodePos(offset)
+	return NewAnchor(pos.id, pos.relativeOffset)
+}
+
+// ResolveAnchor returns the current integer offset of the given anchor. It
+// returns false if the content the anchor sticks to has been removed.
+func (t *Text) ResolveAnchor(a Anchor) (int, bool) {
+	if a.id.Equal(initialNodeID) {
+		return 0, true
+	}
+
+	// NOTE: The anchor at the beginning of the insertion sticks to the first
+	// character of the insertion because there is no character on its left
+	// in the same insertion.
+	absoluteOffset := a.id.offset + a.relativeOffset
+	isBeginning := absoluteOffset == 0
+	if !isBeginning {
+		absoluteOffset--
+	}
+
+	// NOTE: If the insertion of the anchor has been purged by GC, the floor
+	// node can be a node of another insertion.
+	charID := NewRGATreeSplitNodeID(a.id.createdAt, absoluteOffset)
+	node := t.rgaTreeSplit.findFloorNode(charID)
+	if node == nil || !node.id.hasSameCreatedAt(charID) || node.removedAt != nil ||
+		charID.offset-node.id.offset >= node.contentLen() {
+		return 0, false
+	}
+
+	offset, err := t.rgaTreeSplit.indexOf(charID)
+	if err != nil {
+		return 0, false
+	}
+
+	if isBeginning {
+		return offset, true
+	}
+	return offset + 1, true
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func newTextWithContent(ctx *change.Context, content string) *crdt.Text {
+	text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+	fromPos, toPos := text.CreateRange(0, 0)
+	text.Edit(fromPos, toPos, nil, content, nil, ctx.IssueTimeTicket())
+	return text
+}
+
+func TestAnchor(t *testing.T) {
+	t.Run("insert before anchor test", func(t *testing.T) {
+		ctx := helper.TextChangeContext(helper.TestRoot())
+		text := newTextWithContent(ctx, "Hello World")
+
+		anchor := text.CreateAnchor(6)
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Oh, ", nil, ctx.IssueTimeTicket())
+
+		offset, ok := text.ResolveAnchor(anchor)
+		assert.True(t, ok)
+		assert.Equal(t, 10, offset)
+	})
+
+	t.Run("insert inside anchored node test", func(t *testing.T) {
+		ctx := helper.TextChangeContext(helper.TestRoot())
+		text := newTextWithContent(ctx, "Hello World")
+
+		anchor := text.CreateAnchor(6)
+		fromPos, toPos := text.CreateRange(3, 3)
+		text.Edit(fromPos, toPos, nil, "--", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(10, 10)
+		text.Edit(fromPos, toPos, nil, "++", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "Hel--lo Wo++rld", text.String())
+
+		offset, ok := text.ResolveAnchor(anchor)
+		assert.True(t, ok)
+		assert.Equal(t, 8, offset)
+
+		// the content inserted at the anchor is placed after the anchor.
+		fromPos, toPos = text.CreateRange(8, 8)
+		text.Edit(fromPos, toPos, nil, "~", nil, ctx.IssueTimeTicket())
+		offset, ok = text.ResolveAnchor(anchor)
+		assert.True(t, ok)
+		assert.Equal(t, 8, offset)
+
+		beginning := text.CreateAnchor(0)
+		fromPos, toPos = text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, ">", nil, ctx.IssueTimeTicket())
+		offset, ok = text.ResolveAnchor(beginning)
+		assert.True(t, ok)
+		assert.Equal(t, 0, offset)
+	})
+
+	t.Run("delete of anchor test", func(t *testing.T) {
+		ctx := helper.TextChangeContext(helper.TestRoot())
+		text := newTextWithContent(ctx, "Hello World")
+
+		anchor := text.CreateAnchor(6)
+		fromPos, toPos := text.CreateRange(6, 11)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		offset, ok := text.ResolveAnchor(anchor)
+		assert.True(t, ok)
+		assert.Equal(t, 6, offset)
+
+		fromPos, toPos = text.CreateRange(4, 6)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		_, ok = text.ResolveAnchor(anchor)
+		assert.False(t, ok)
+	})
+
+	t.Run("purged anchor test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello")
+		root.RegisterElement(text)
+
+		fromPos, toPos := text.CreateRange(5, 5)
+		text.Edit(fromPos, toPos, nil, "World", nil, ctx.IssueTimeTicket())
+		anchor := text.CreateAnchor(8)
+		offset, ok := text.ResolveAnchor(anchor)
+		assert.True(t, ok)
+		assert.Equal(t, 8, offset)
+
+		fromPos, toPos = text.CreateRange(5, 10)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		root.RegisterTextElementWithGarbage(text)
+		root.GarbageCollect(time.MaxTicket)
+		_, ok = text.ResolveAnchor(anchor)
+		assert.False(t, ok)
+	})
+}
@@ -17,6 +17,8 @@
 package crdt
 
 import (
+	"fmt"
+
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -53,6 +55,18 @@ func (a *Array) Get(idx int) Element {
 	return a.elements.Get(idx).elem
 }
 
+// GetChecked returns the element of the given index like Get, but it
+// returns an error if the index is out of the range of the live elements.
+// The element is found by the weights of the index tree skipping the
+// removed elements.
+func (a *Array) GetChecked(idx int) (Element, error) {
+	if idx < 0 || idx >= a.Len() {
+		return nil, fmt.Errorf("index %d of length %d: %w", idx, a.Len(), ErrOutOfRange)
+	}
+
+	return a.Get(idx), nil
+}
+
 // FindPrevCreatedAt returns the creation time of the previous element of the
 // given element.
 func (a *Array) FindPrevCreatedAt(createdAt *time.Ticket) *time.Ticket {
@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -39,4 +40,55 @@ func TestArray(t *testing.T) {
 		a.Add(crdt.NewPrimitive("3", ctx.IssueTimeTicket()))
 		assert.Equal(t, `["1","2","3"]`, a.Marshal())
 	})
+
+	t.Run("get checked test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		a := crdt.NewArray(crdt.NewRGATreeList(), ctx.IssueTimeTicket())
+		_, err := a.GetChecked(0)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+
+		for _, v := range []string{"1", "2", "3", "4"} {
+			a.Add(crdt.NewPrimitive(v, ctx.IssueTimeTicket()))
+		}
+		a.Delete(1, ctx.IssueTimeTicket())
+		assert.Equal(t, 3, a.Len())
+
+		var values []string
+		for i := 0; i < a.Len(); i++ {
+			elem, err := a.GetChecked(i)
+			assert.NoError(t, err)
+			values = append(values, elem.Marshal())
+		}
+		assert.Equal(t, []string{`"1"`, `"3"`, `"4"`}, values)
+
+		_, err = a.GetChecked(3)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		_, err = a.GetChecked(-1)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+	})
+
+	t.Run("concurrent insert with same lamport test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		a1 := crdt.NewArray(crdt.NewRGATreeList(), ctx.IssueTimeTicket())
+		a1.Add(crdt.NewPrimitive("0", ctx.IssueTimeTicket()))
+		a2 := a1.DeepCopy().(*crdt.Array)
+		prevCreatedAt := a1.LastCreatedAt()
+
+		actorA, _ := time.ActorIDFromHex("000000000000000000000001")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000002")
+		ticketA := time.NewTicket(10, 0, actorA)
+		ticketB := time.NewTicket(10, 0, actorB)
+
+		// NOTE: The inserts are applied in the reverse order on each replica.
+		a1.InsertAfter(prevCreatedAt, crdt.NewPrimitive("A", ticketA))
+		a1.InsertAfter(prevCreatedAt, crdt.NewPrimitive("B", ticketB))
+		a2.InsertAfter(prevCreatedAt, crdt.NewPrimitive("B", ticketB))
+		a2.InsertAfter(prevCreatedAt, crdt.NewPrimitive("A", ticketA))
+		assert.Equal(t, `["0","B","A"]`, a1.Marshal())
+		assert.Equal(t, a1.Marshal(), a2.Marshal())
+	})
 }
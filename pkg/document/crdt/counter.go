@@ -19,6 +19,7 @@ package crdt
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -30,6 +31,7 @@ type CounterType int
 const (
 	IntegerCnt CounterType = iota
 	LongCnt
+	DoubleCnt
 )
 
 // CounterValueFromBytes parses the given bytes into value.
@@ -40,6 +42,8 @@ func CounterValueFromBytes(counterType CounterType, value []byte) interface{} {
 		return int(val)
 	case LongCnt:
 		return int64(binary.LittleEndian.Uint64(value))
+	case DoubleCnt:
+		return math.Float64frombits(binary.LittleEndian.Uint64(value))
 	}
 
 	panic("unsupported type")
@@ -69,6 +73,12 @@ func NewCounter(valueType CounterType, value interface{}, createdAt *time.Ticket
 			value:     castToLong(value),
 			createdAt: createdAt,
 		}
+	case DoubleCnt:
+		return &Counter{
+			valueType: DoubleCnt,
+			value:     castToDouble(value),
+			createdAt: createdAt,
+		}
 	}
 
 	panic("unsupported type")
@@ -85,6 +95,10 @@ func (p *Counter) Bytes() []byte {
 		bytes := [8]byte{}
 		binary.LittleEndian.PutUint64(bytes[:], uint64(val))
 		return bytes[:]
+	case float64:
+		bytes := [8]byte{}
+		binary.LittleEndian.PutUint64(bytes[:], math.Float64bits(val))
+		return bytes[:]
 	}
 
 	panic("unsupported type")
@@ -92,6 +106,9 @@ func (p *Counter) Bytes() []byte {
 
 // Marshal returns the JSON encoding of the value.
 func (p *Counter) Marshal() string {
+	if p.valueType == DoubleCnt {
+		return marshalDouble(p.value.(float64))
+	}
 	return fmt.Sprintf("%d", p.value)
 }
 
@@ -136,16 +153,22 @@ func (p *Counter) Remove(removedAt *time.Ticket) bool {
 	return false
 }
 
+// Value returns the value of this counter, which is int32, int64 or float64
+// according to the type of the counter.
+func (p *Counter) Value() interface{} {
+	return p.value
+}
+
 // ValueType returns the type of the value.
 func (p *Counter) ValueType() CounterType {
 	return p.valueType
 }
 
 // Increase increases integer, long or double.
-// If the result of the operation is greater than MaxInt32 or less
-// than MinInt32, Counter's value type can be changed Integer to Long.
-// Because in golang, int can be either int32 or int64.
-// So we need to assert int to int32.
+// The operand is cast to the value type of the counter. If the result of
+// an integer or long counter is out of its range, it wraps around in two's
+// complement, e.g. MaxInt32 + 1 of an integer counter is MinInt32, so that
+// all replicas converge to the same value regardless of the order.
 func (p *Counter) Increase(v *Primitive) *Counter {
 	if !p.IsNumericType() || !v.IsNumericType() {
 		panic("unsupported type")
@@ -155,6 +178,8 @@ func (p *Counter) Increase(v *Primitive) *Counter {
 		p.value = p.value.(int32) + castToInt(v.value)
 	case LongCnt:
 		p.value = p.value.(int64) + castToLong(v.value)
+	case DoubleCnt:
+		p.value = p.value.(float64) + castToDouble(v.value)
 	default:
 		panic("unsupported type")
 	}
@@ -165,7 +190,7 @@ func (p *Counter) Increase(v *Primitive) *Counter {
 // IsNumericType checks for numeric types.
 func (p *Counter) IsNumericType() bool {
 	t := p.valueType
-	return t == IntegerCnt || t == LongCnt
+	return t == IntegerCnt || t == LongCnt || t == DoubleCnt
 }
 
 // castToInt casts numeric type to int32.
@@ -203,3 +228,21 @@ func castToLong(value interface{}) int64 {
 		panic("unsupported type")
 	}
 }
+
+// castToDouble casts numeric type to float64.
+func castToDouble(value interface{}) float64 {
+	switch val := value.(type) {
+	case float64:
+		return val
+	case float32:
+		return float64(val)
+	case int64:
+		return float64(val)
+	case int32:
+		return float64(val)
+	case int:
+		return float64(val)
+	default:
+		panic("unsupported type")
+	}
+}
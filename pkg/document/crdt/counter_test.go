@@ -120,4 +120,39 @@ func TestCounter(t *testing.T) {
 		assert.Equal(t, integer.ValueType(), crdt.IntegerCnt)
 		assert.Equal(t, integer.Marshal(), strconv.FormatInt(math.MinInt32, 10))
 	})
+
+	t.Run("double counter test", func(t *testing.T) {
+		double := crdt.NewCounter(crdt.DoubleCnt, 1, time.InitialTicket)
+		assert.Equal(t, crdt.DoubleCnt, double.ValueType())
+
+		double.Increase(crdt.NewPrimitive(0.5, time.InitialTicket))
+		double.Increase(crdt.NewPrimitive(int64(2), time.InitialTicket))
+		assert.Equal(t, "3.500000", double.Marshal())
+		assert.Equal(t, 3.5, crdt.CounterValueFromBytes(crdt.DoubleCnt, double.Bytes()))
+	})
+
+	t.Run("long counter value overflow test", func(t *testing.T) {
+		long := crdt.NewCounter(crdt.LongCnt, int64(math.MaxInt64), time.InitialTicket)
+		long.Increase(crdt.NewPrimitive(int64(1), time.InitialTicket))
+		assert.Equal(t, strconv.FormatInt(math.MinInt64, 10), long.Marshal())
+		assert.Equal(t, int64(math.MinInt64), crdt.CounterValueFromBytes(crdt.LongCnt, long.Bytes()))
+	})
+
+	t.Run("counter value test", func(t *testing.T) {
+		integer := crdt.NewCounter(crdt.IntegerCnt, 1, time.InitialTicket)
+		integer.Increase(crdt.NewPrimitive(2, time.InitialTicket))
+		assert.Equal(t, int32(3), integer.Value())
+
+		long := crdt.NewCounter(crdt.LongCnt, int64(math.MaxInt32+1), time.InitialTicket)
+		assert.Equal(t, int64(math.MaxInt32+1), long.Value())
+
+		double := crdt.NewCounter(crdt.DoubleCnt, 0.5, time.InitialTicket)
+		assert.Equal(t, 0.5, double.Value())
+
+		// the counter is marshaled as a bare number in the object.
+		obj := crdt.NewObject(crdt.NewElementRHT(), time.InitialTicket)
+		obj.Set("cnt", integer)
+		obj.Set("long", long)
+		assert.Equal(t, `{"cnt":3,"long":2147483648}`, obj.Marshal())
+	})
 }
@@ -0,0 +1,164 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	// ErrUnregisteredElementType is returned when the type tag of a custom
+	// element is not registered.
+	ErrUnregisteredElementType = errors.New("unregistered element type")
+
+	// ErrInvalidElementEncoding is returned when the given bytes are not an
+	// encoding of a custom element.
+	ErrInvalidElementEncoding = errors.New("invalid element encoding")
+)
+
+// CustomElement represents an element whose type is defined outside of this
+// package. The type is identified by the tag registered with
+// RegisterElementType, and the element encodes its own state including its
+// tickets.
+type CustomElement interface {
+	Element
+
+	// TypeTag returns the tag the type of this element is registered with.
+	TypeTag() string
+
+	// MarshalBinary returns the binary encoding of this element.
+	MarshalBinary() ([]byte, error)
+
+	// UnmarshalBinary restores this element from the given binary encoding.
+	UnmarshalBinary(data []byte) error
+}
+
+var elementTypes = struct {
+	sync.RWMutex
+	factories map[string]func() Element
+}{factories: make(map[string]func() Element)}
+
+// RegisterElementType registers the factory of a custom element type with the
+// given tag. The factory returns an empty element that is restored by
+// UnmarshalBinary when the element is decoded. It panics if the tag is empty,
+// the factory is nil, or the tag is already registered.
+func RegisterElementType(tag string, factory func() Element) {
+	if tag == "" {
+		panic("crdt: element type tag is empty")
+	}
+	if factory == nil {
+		panic("crdt: element type factory is nil for " + tag)
+	}
+
+	elementTypes.Lock()
+	defer elementTypes.Unlock()
+
+	if _, ok := elementTypes.factories[tag]; ok {
+		panic("crdt: element type registered twice for " + tag)
+	}
+	elementTypes.factories[tag] = factory
+}
+
+// ValidateElementType returns an error if the given element, or one of its
+// descendants, is a custom element whose type is not registered.
+func ValidateElementType(elem Element) error {
+	if err := validateElementType(elem); err != nil {
+		return err
+	}
+
+	// NOTE: Stopping the traversal only stops the level of the current
+	// element, so the following siblings of its parent are still visited.
+	// Keep the first error not to be overwritten by them.
+	var err error
+	if container, ok := elem.(Container); ok {
+		container.Descendants(func(elem Element, _ Container) bool {
+			if e := validateElementType(elem); e != nil && err == nil {
+				err = e
+			}
+			return err != nil
+		})
+	}
+	return err
+}
+
+// EncodeCustomElement returns the binary encoding of the given custom element
+// prefixed by its type tag, so that DecodeCustomElement can find the factory
+// of the type.
+func EncodeCustomElement(elem CustomElement) ([]byte, error) {
+	tag := elem.TypeTag()
+	if _, err := elementFactoryOf(tag); err != nil {
+		return nil, err
+	}
+
+	data, err := elem.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal element of type %s: %w", tag, err)
+	}
+
+	encoded := binary.AppendUvarint(nil, uint64(len(tag)))
+	encoded = append(encoded, tag...)
+	return append(encoded, data...), nil
+}
+
+// DecodeCustomElement creates a custom element from the given bytes encoded by
+// EncodeCustomElement with the factory registered for its type tag.
+func DecodeCustomElement(encoded []byte) (CustomElement, error) {
+	tagLen, n := binary.Uvarint(encoded)
+	if n <= 0 || tagLen > uint64(len(encoded)-n) {
+		return nil, fmt.Errorf("decode element: %w", ErrInvalidElementEncoding)
+	}
+	tag := string(encoded[n : n+int(tagLen)])
+
+	factory, err := elementFactoryOf(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	elem, ok := factory().(CustomElement)
+	if !ok {
+		return nil, fmt.Errorf("factory of %s is not a custom element: %w", tag, ErrInvalidElementEncoding)
+	}
+	if err := elem.UnmarshalBinary(encoded[n+int(tagLen):]); err != nil {
+		return nil, fmt.Errorf("unmarshal element of type %s: %w", tag, err)
+	}
+
+	return elem, nil
+}
+
+func validateElementType(elem Element) error {
+	custom, ok := elem.(CustomElement)
+	if !ok {
+		return nil
+	}
+
+	_, err := elementFactoryOf(custom.TypeTag())
+	return err
+}
+
+func elementFactoryOf(tag string) (func() Element, error) {
+	elementTypes.RLock()
+	defer elementTypes.RUnlock()
+
+	factory, ok := elementTypes.factories[tag]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", tag, ErrUnregisteredElementType)
+	}
+	return factory, nil
+}
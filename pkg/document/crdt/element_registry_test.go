@@ -0,0 +1,168 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt_test
+
+import (
+	"context"
+	gojson "encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+// point is a toy custom element holding a geo-coordinate.
+type point struct {
+	Lat, Lng  float64
+	createdAt *time.Ticket
+	movedAt   *time.Ticket
+	removedAt *time.Ticket
+}
+
+type encodedPoint struct {
+	Lat, Lng  float64
+	Lamport   int64
+	Delimiter uint32
+	Actor     string
+}
+
+func init() {
+	crdt.RegisterElementType("point", func() crdt.Element { return &point{} })
+}
+
+func (p *point) TypeTag() string { return "point" }
+
+func (p *point) Marshal() string { return fmt.Sprintf(`{"lat":%g,"lng":%g}`, p.Lat, p.Lng) }
+
+func (p *point) DeepCopy() crdt.Element {
+	clone := *p
+	return &clone
+}
+
+func (p *point) CreatedAt() *time.Ticket { return p.createdAt }
+
+func (p *point) MovedAt() *time.Ticket { return p.movedAt }
+
+func (p *point) SetMovedAt(movedAt *time.Ticket) { p.movedAt = movedAt }
+
+func (p *point) RemovedAt() *time.Ticket { return p.removedAt }
+
+func (p *point) Remove(removedAt *time.Ticket) bool {
+	if removedAt.After(p.createdAt) && (p.removedAt == nil || removedAt.After(p.removedAt)) {
+		p.removedAt = removedAt
+		return true
+	}
+	return false
+}
+
+func (p *point) MarshalBinary() ([]byte, error) {
+	return gojson.Marshal(encodedPoint{
+		Lat:       p.Lat,
+		Lng:       p.Lng,
+		Lamport:   p.createdAt.Lamport(),
+		Delimiter: p.createdAt.Delimiter(),
+		Actor:     p.createdAt.ActorIDHex(),
+	})
+}
+
+func (p *point) UnmarshalBinary(data []byte) error {
+	var encoded encodedPoint
+	if err := gojson.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	actorID, err := time.ActorIDFromHex(encoded.Actor)
+	if err != nil {
+		return err
+	}
+
+	p.Lat, p.Lng = encoded.Lat, encoded.Lng
+	p.createdAt = time.NewTicket(encoded.Lamport, encoded.Delimiter, actorID)
+	return nil
+}
+
+// unknown is a custom element whose type is not registered.
+type unknown struct {
+	point
+}
+
+func (u *unknown) TypeTag() string { return "unknown" }
+
+func TestElementRegistry(t *testing.T) {
+	t.Run("encode and decode custom element test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		p := &point{Lat: 37.5, Lng: 127.0, createdAt: ctx.IssueTimeTicket()}
+		encoded, err := crdt.EncodeCustomElement(p)
+		assert.NoError(t, err)
+
+		decoded, err := crdt.DecodeCustomElement(encoded)
+		assert.NoError(t, err)
+		assert.Equal(t, "point", decoded.TypeTag())
+		assert.Equal(t, p.Marshal(), decoded.Marshal())
+		assert.Equal(t, p.CreatedAt().Key(), decoded.CreatedAt().Key())
+
+		_, err = crdt.DecodeCustomElement(encoded[:1])
+		assert.ErrorIs(t, err, crdt.ErrInvalidElementEncoding)
+	})
+
+	t.Run("unregistered element type test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		u := &unknown{point{createdAt: ctx.IssueTimeTicket()}}
+		_, err := crdt.EncodeCustomElement(u)
+		assert.ErrorIs(t, err, crdt.ErrUnregisteredElementType)
+
+		obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		obj.Set("u", u)
+		assert.ErrorIs(t, crdt.ValidateElementType(obj), crdt.ErrUnregisteredElementType)
+
+		// the unregistered element nested before the siblings is found.
+		inner := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		inner.Set("u", &unknown{point{createdAt: ctx.IssueTimeTicket()}})
+		arr := crdt.NewArray(crdt.NewRGATreeList(), ctx.IssueTimeTicket())
+		arr.Add(inner)
+		arr.Add(crdt.NewPrimitive(1, ctx.IssueTimeTicket()))
+		arr.Add(crdt.NewPrimitive(2, ctx.IssueTimeTicket()))
+		assert.ErrorIs(t, crdt.ValidateElementType(arr), crdt.ErrUnregisteredElementType)
+
+		assert.Panics(t, func() {
+			crdt.RegisterElementType("point", func() crdt.Element { return &point{} })
+		})
+	})
+
+	t.Run("set custom element test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		p := &point{Lat: 1, Lng: 2, createdAt: ctx.IssueTimeTicket()}
+		op := operations.NewSet(root.Object().CreatedAt(), "p", p, ctx.IssueTimeTicket())
+		assert.NoError(t, op.Validate(root))
+		assert.NoError(t, op.Execute(context.Background(), root))
+		assert.Equal(t, `{"p":{"lat":1,"lng":2}}`, root.Object().Marshal())
+
+		u := &unknown{point{createdAt: ctx.IssueTimeTicket()}}
+		op = operations.NewSet(root.Object().CreatedAt(), "u", u, ctx.IssueTimeTicket())
+		assert.ErrorIs(t, op.Validate(root), crdt.ErrUnregisteredElementType)
+	})
+}
@@ -128,6 +128,17 @@ func (rht *ElementRHT) Delete(k string, deletedAt *time.Ticket) Element {
 	return node.elem
 }
 
+// keyOf returns the key of the given element, and whether the element is the
+// latest one of the key.
+func (rht *ElementRHT) keyOf(elem Element) (string, bool) {
+	node, ok := rht.nodeMapByCreatedAt[elem.CreatedAt().Key()]
+	if !ok {
+		return "", false
+	}
+
+	return node.key, rht.nodeMapByKey[node.key] == node
+}
+
 // DeleteByCreatedAt deletes the Element of the given creation time.
 func (rht *ElementRHT) DeleteByCreatedAt(createdAt *time.Ticket, deletedAt *time.Ticket) Element {
 	node, ok := rht.nodeMapByCreatedAt[createdAt.Key()]
@@ -166,6 +177,44 @@ func (rht *ElementRHT) Nodes() []*ElementRHTNode {
 	return nodes
 }
 
+// ForEach calls the given function for each element that is not removed in
+// the order of the keys. It stops the iteration if the function returns
+// false.
+func (rht *ElementRHT) ForEach(fn func(key string, elem Element) bool) {
+	keys := make([]string, 0, len(rht.nodeMapByKey))
+	for key, node := range rht.nodeMapByKey {
+		if !node.isRemoved() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !fn(key, rht.nodeMapByKey[key].elem) {
+			return
+		}
+	}
+}
+
+// Len returns the number of the elements that are not removed.
+func (rht *ElementRHT) Len() int {
+	count := 0
+	for _, node := range rht.nodeMapByKey {
+		if !node.isRemoved() {
+			count++
+		}
+	}
+
+	return count
+}
+
+// RawLen returns the number of all the elements kept in this hashtable,
+// including the removed ones and the ones overwritten by Set, which remain
+// until they are purged by GC.
+func (rht *ElementRHT) RawLen() int {
+	return len(rht.nodeMapByCreatedAt)
+}
+
 // purge physically purge child element.
 func (rht *ElementRHT) purge(elem Element) {
 	node, ok := rht.nodeMapByCreatedAt[elem.CreatedAt().Key()]
@@ -180,6 +229,48 @@ func (rht *ElementRHT) purge(elem Element) {
 	}
 }
 
+// StructureAsString returns a String containing the metadata of this
+// hashtable for debugging purpose. The nodes, including the removed ones,
+// are written in the order of the keys and then of the creation times. A
+// live node is written as `[KEY CREATED_AT VALUE]` and a removed node as
+// `{KEY CREATED_AT VALUE}`, where VALUE is the structure of a nested object
+// or the JSON of the other elements.
+func (rht *ElementRHT) StructureAsString() string {
+	nodes := make([]*ElementRHTNode, 0, len(rht.nodeMapByCreatedAt))
+	for _, node := range rht.nodeMapByCreatedAt {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].key != nodes[j].key {
+			return nodes[i].key < nodes[j].key
+		}
+		return nodes[i].elem.CreatedAt().Compare(nodes[j].elem.CreatedAt()) < 0
+	})
+
+	sb := strings.Builder{}
+	for _, node := range nodes {
+		var value string
+		if obj, ok := node.elem.(*Object); ok {
+			value = obj.StructureAsString()
+		} else {
+			value = node.elem.Marshal()
+		}
+
+		format := "[%s %s %s]"
+		if node.isRemoved() {
+			format = "{%s %s %s}"
+		}
+		sb.WriteString(fmt.Sprintf(
+			format,
+			fmt.Sprintf(`"%s"`, EscapeString(node.key)),
+			node.elem.CreatedAt().StructureAsString(),
+			value,
+		))
+	}
+
+	return sb.String()
+}
+
 // Marshal returns the JSON encoding of this map.
 func (rht *ElementRHT) Marshal() string {
 	members := rht.Elements()
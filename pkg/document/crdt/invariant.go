@@ -0,0 +1,82 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrInvariantViolation is returned when an invariant of the data structures
+// is broken, which means that the structure has been corrupted.
+var ErrInvariantViolation = errors.New("invariant violation")
+
+// strictMode is whether to panic on invariant violations.
+var strictMode atomic.Bool
+
+// violationHandler is the handler of the invariant violations out of strict
+// mode.
+var violationHandler atomic.Pointer[func(err error)]
+
+// SetStrictMode sets whether to panic on invariant violations of the data
+// structures, such as splitting a node beyond its content, inserting a node
+// that is already linked, or purging a node that is not linked. In strict
+// mode, which is meant for tests, a violation panics with the diagnostic
+// context, and the more expensive invariants are also checked. Otherwise,
+// the violation is returned as an error by the operations that can fail, or
+// reported to the handler set by SetViolationHandler and skipped by the
+// others, so that the production degrades gracefully.
+func SetStrictMode(strict bool) {
+	strictMode.Store(strict)
+}
+
+// IsStrictMode returns whether the strict mode is enabled.
+func IsStrictMode() bool {
+	return strictMode.Load()
+}
+
+// SetViolationHandler sets the handler called with every invariant violation
+// out of strict mode, such as to log it or to count it. The violations are
+// not reported if the handler is nil, which is the default.
+func SetViolationHandler(handler func(err error)) {
+	if handler == nil {
+		violationHandler.Store(nil)
+		return
+	}
+
+	violationHandler.Store(&handler)
+}
+
+// checkInvariant returns nil if the given condition holds. If it doesn't,
+// it panics in strict mode, or returns the violation with the given
+// diagnostic context after reporting it to the violation handler otherwise.
+func checkInvariant(cond bool, format string, args ...interface{}) error {
+	if cond {
+		return nil
+	}
+
+	err := fmt.Errorf(format+": %w", append(args, ErrInvariantViolation)...)
+	if strictMode.Load() {
+		panic(err)
+	}
+
+	if handler := violationHandler.Load(); handler != nil {
+		(*handler)(err)
+	}
+	return err
+}
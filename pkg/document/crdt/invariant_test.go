@@ -0,0 +1,112 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+// recoverError runs the given function and returns the error it panics with.
+func recoverError(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, _ = r.(error)
+		}
+	}()
+	fn()
+	return nil
+}
+
+func TestStrictMode(t *testing.T) {
+	t.Run("strict mode test", func(t *testing.T) {
+		crdt.SetStrictMode(true)
+		defer crdt.SetStrictMode(false)
+		assert.True(t, crdt.IsStrictMode())
+
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		rgaTreeSplit := crdt.NewRGATreeSplit(crdt.InitialTextNode())
+		text := crdt.NewText(rgaTreeSplit, ctx.IssueTimeTicket())
+		assert.NoError(t, text.Append("Hello", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append(" World", nil, ctx.IssueTimeTicket()))
+
+		// 01. inserting the node that is already linked.
+		linked := text.Nodes()[1]
+		err := recoverError(func() {
+			rgaTreeSplit.InsertAfter(rgaTreeSplit.InitialHead(), linked)
+		})
+		assert.ErrorIs(t, err, crdt.ErrInvariantViolation)
+		assert.Contains(t, err.Error(), "insert linked")
+
+		// 02. inserting a node whose ID is already in the tree.
+		duplicated := crdt.NewRGATreeSplitNode(linked.ID(), crdt.NewTextValue("!", crdt.NewRHT()))
+		err = recoverError(func() {
+			rgaTreeSplit.InsertAfter(linked, duplicated)
+		})
+		assert.ErrorIs(t, err, crdt.ErrInvariantViolation)
+		assert.Contains(t, err.Error(), "insert duplicated")
+
+		// 03. splitting a node beyond its content.
+		beyond := crdt.NewRGATreeSplitNodePos(linked.ID(), linked.Len()+1)
+		err = recoverError(func() {
+			text.Edit(beyond, beyond, nil, "!", nil, ctx.IssueTimeTicket())
+		})
+		assert.ErrorIs(t, err, crdt.ErrInvariantViolation)
+		assert.Contains(t, err.Error(), "beyond length")
+	})
+
+	t.Run("non-strict mode test", func(t *testing.T) {
+		var violations []error
+		crdt.SetViolationHandler(func(err error) {
+			violations = append(violations, err)
+		})
+		defer crdt.SetViolationHandler(nil)
+		assert.False(t, crdt.IsStrictMode())
+
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		rgaTreeSplit := crdt.NewRGATreeSplit(crdt.InitialTextNode())
+		text := crdt.NewText(rgaTreeSplit, ctx.IssueTimeTicket())
+		assert.NoError(t, text.Append("Hello", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append(" World", nil, ctx.IssueTimeTicket()))
+
+		// 01. the violation is reported and the broken operation is skipped.
+		err := recoverError(func() {
+			rgaTreeSplit.InsertAfter(rgaTreeSplit.InitialHead(), text.Nodes()[1])
+		})
+		assert.NoError(t, err)
+		assert.Len(t, violations, 1)
+		assert.ErrorIs(t, violations[0], crdt.ErrInvariantViolation)
+		assert.Contains(t, violations[0].Error(), "insert linked")
+		assert.Equal(t, "Hello World", text.String())
+		assert.True(t, text.CheckLinks())
+
+		// 02. the violation is returned by the operation that can fail.
+		node := text.Nodes()[1]
+		beyond := crdt.NewRGATreeSplitNodePos(node.ID(), node.Len()+1)
+		_, _, err = text.Edit(beyond, beyond, nil, "!", nil, ctx.IssueTimeTicket())
+		assert.ErrorIs(t, err, crdt.ErrInvariantViolation)
+		assert.Len(t, violations, 2)
+		assert.Equal(t, "Hello World", text.String())
+		assert.True(t, text.CheckLinks())
+	})
+}
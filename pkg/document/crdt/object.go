@@ -17,9 +17,16 @@
 package crdt
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// ErrOutdatedSet is returned when the value would be set before the current
+// value was created, so the set would be overridden by the current value.
+var ErrOutdatedSet = errors.New("outdated set")
+
 // Object represents a JSON object, but unlike regular JSON, it has time
 // tickets which is created by logical clock.
 type Object struct {
@@ -27,6 +34,9 @@ type Object struct {
 	createdAt   *time.Ticket
 	movedAt     *time.Ticket
 	removedAt   *time.Ticket
+
+	// onChanges are called with the change of each member if registered.
+	onChanges []func(change ObjectChange)
 }
 
 // NewObject creates a new instance of Object.
@@ -44,7 +54,41 @@ func (o *Object) Purge(elem Element) {
 
 // Set sets the given element of the given key.
 func (o *Object) Set(k string, v Element) Element {
-	return o.memberNodes.Set(k, v)
+	removed := o.memberNodes.Set(k, v)
+	o.notifySet(v)
+	return removed
+}
+
+// CompareAndSet sets the given element of the given key only if the creation
+// time of the current value of the key equals the expected ticket, and
+// returns the overwritten value like Set and whether it has been set. A nil
+// expected ticket matches the key without a value. Like Set, the values are
+// ordered by their creation time, so it returns an error if the given
+// element is not created after the current value.
+func (o *Object) CompareAndSet(
+	key string,
+	expectedTicket *time.Ticket,
+	v Element,
+) (Element, bool, error) {
+	current := o.memberNodes.Get(key)
+
+	var currentTicket *time.Ticket
+	if current != nil {
+		currentTicket = current.CreatedAt()
+	}
+	if currentTicket == nil || expectedTicket == nil {
+		if currentTicket != expectedTicket {
+			return nil, false, nil
+		}
+	} else if currentTicket.Compare(expectedTicket) != 0 {
+		return nil, false, nil
+	}
+
+	if currentTicket != nil && !v.CreatedAt().After(currentTicket) {
+		return nil, false, fmt.Errorf("set %s at %s: %w", key, v.CreatedAt().Key(), ErrOutdatedSet)
+	}
+
+	return o.Set(key, v), true, nil
 }
 
 // Members returns the member of this object as a map.
@@ -52,6 +96,36 @@ func (o *Object) Members() map[string]Element {
 	return o.memberNodes.Elements()
 }
 
+// Keys returns the keys of the members of this object in order. The removed
+// members are skipped.
+func (o *Object) Keys() []string {
+	var keys []string
+	o.memberNodes.ForEach(func(key string, _ Element) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Len returns the number of the members of this object. The removed members
+// are not counted.
+func (o *Object) Len() int {
+	return o.memberNodes.Len()
+}
+
+// RawLen returns the number of the elements kept in this object including
+// the removed ones, which remain as tombstones until they are purged by GC.
+func (o *Object) RawLen() int {
+	return o.memberNodes.RawLen()
+}
+
+// ForEach calls the given function for each member of this object in the
+// order of the keys. The removed members are skipped, and the iteration
+// stops if the function returns false.
+func (o *Object) ForEach(fn func(key string, value Element) bool) {
+	o.memberNodes.ForEach(fn)
+}
+
 // Get returns the value of the given key.
 func (o *Object) Get(k string) Element {
 	return o.memberNodes.Get(k)
@@ -64,12 +138,39 @@ func (o *Object) Has(k string) bool {
 
 // DeleteByCreatedAt deletes the element of the given creation time.
 func (o *Object) DeleteByCreatedAt(createdAt *time.Ticket, deletedAt *time.Ticket) Element {
-	return o.memberNodes.DeleteByCreatedAt(createdAt, deletedAt)
+	deleted := o.memberNodes.DeleteByCreatedAt(createdAt, deletedAt)
+	if deleted != nil {
+		o.notifyDelete(deleted)
+	}
+	return deleted
 }
 
 // Delete deletes the element of the given key.
 func (o *Object) Delete(k string, deletedAt *time.Ticket) Element {
-	return o.memberNodes.Delete(k, deletedAt)
+	deleted := o.memberNodes.Delete(k, deletedAt)
+	if deleted != nil {
+		o.notifyDelete(deleted)
+	}
+	return deleted
+}
+
+// Clear removes all the members of this object at the given time, and
+// returns the removed members. Like Delete, only the members created before
+// the given time are removed, so the values set concurrently with newer
+// tickets survive the clear. The removed members are kept as tombstones, so
+// callers should register them to the root as removed to be collected by GC.
+func (o *Object) Clear(executedAt *time.Ticket) []Element {
+	var removed []Element
+	for _, node := range o.memberNodes.Nodes() {
+		if node.isRemoved() || !node.Remove(executedAt) {
+			continue
+		}
+
+		o.notifyDelete(node.elem)
+		removed = append(removed, node.elem)
+	}
+
+	return removed
 }
 
 // Descendants traverse the descendants of this object.
@@ -93,6 +194,13 @@ func (o *Object) Marshal() string {
 	return o.memberNodes.Marshal()
 }
 
+// StructureAsString returns a String containing the metadata of this object
+// for debugging purpose. The members are enclosed in parentheses in the format
+// of ElementRHT.StructureAsString, so it is stable as well.
+func (o *Object) StructureAsString() string {
+	return "(" + o.memberNodes.StructureAsString() + ")"
+}
+
 // DeepCopy copies itself deeply.
 func (o *Object) DeepCopy() Element {
 	members := NewElementRHT()
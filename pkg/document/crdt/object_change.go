@@ -0,0 +1,77 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+// ObjectChangeType is the type of ObjectChange.
+type ObjectChangeType string
+
+// The values below are types of ObjectChange.
+const (
+	// ObjectSetChange means that the member has been set.
+	ObjectSetChange ObjectChangeType = "set"
+
+	// ObjectDeleteChange means that the member has been deleted.
+	ObjectDeleteChange ObjectChangeType = "delete"
+)
+
+// ObjectChange represents a change of a member of Object. Element is the new
+// element of the key, and it is nil if the member has been deleted.
+type ObjectChange struct {
+	Type    ObjectChangeType
+	Key     string
+	Element Element
+}
+
+// OnChange registers the given function to be called with the change of each
+// member of this Object after the change is applied. Multiple functions can
+// be registered, and they are called in the order of registration. The
+// registered functions are not copied by DeepCopy.
+func (o *Object) OnChange(fn func(change ObjectChange)) {
+	o.onChanges = append(o.onChanges, fn)
+}
+
+// notifySet notifies the registered functions that the given element has
+// been set. It is not notified if the element has lost to a newer element of
+// the same key.
+func (o *Object) notifySet(elem Element) {
+	if len(o.onChanges) == 0 {
+		return
+	}
+
+	if key, ok := o.memberNodes.keyOf(elem); ok {
+		o.notify(ObjectChange{Type: ObjectSetChange, Key: key, Element: elem})
+	}
+}
+
+// notifyDelete notifies the registered functions that the given element has
+// been deleted. It is not notified if the element had already been replaced
+// by another element of the same key.
+func (o *Object) notifyDelete(elem Element) {
+	if len(o.onChanges) == 0 {
+		return
+	}
+
+	if key, ok := o.memberNodes.keyOf(elem); ok {
+		o.notify(ObjectChange{Type: ObjectDeleteChange, Key: key})
+	}
+}
+
+func (o *Object) notify(change ObjectChange) {
+	for _, fn := range o.onChanges {
+		fn(change)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// MergePolicy decides which value is kept when the same key of the objects
+// has different values that can't be merged in DeepMerge.
+type MergePolicy int
+
+const (
+	// PreferExisting keeps the value of this object.
+	PreferExisting MergePolicy = iota
+
+	// PreferIncoming takes the value of the merged object.
+	PreferIncoming
+
+	// PreferNewerTicket takes the value created later.
+	PreferNewerTicket
+)
+
+// DeepMerge merges the members of the given object into this object. The
+// nested objects are merged recursively, the texts forked from the same text
+// are merged with Text.Merge, and the other conflicts are resolved by the
+// given policy.
+//
+// The values taken from the given object are copied with the new tickets,
+// which are issued from the given ticket(`executedAt`) by increasing its
+// delimiter like a change does, so that they win the existing values. The
+// copied values are not registered in the root; like Set, registering them
+// is up to the caller.
+func (o *Object) DeepMerge(other *Object, policy MergePolicy, executedAt *time.Ticket) {
+	delimiter := executedAt.Delimiter()
+	issue := func() *time.Ticket {
+		ticket := time.NewTicket(executedAt.Lamport(), delimiter, executedAt.ActorID())
+		delimiter++
+		return ticket
+	}
+
+	o.deepMerge(other, policy, issue)
+}
+
+func (o *Object) deepMerge(other *Object, policy MergePolicy, issue func() *time.Ticket) {
+	incomingMembers := other.Members()
+	keys := make([]string, 0, len(incomingMembers))
+	for k := range incomingMembers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		incoming := incomingMembers[k]
+		existing := o.Get(k)
+		if existing == nil {
+			o.Set(k, restamp(incoming, issue))
+			continue
+		}
+
+		switch existing := existing.(type) {
+		case *Object:
+			if incoming, ok := incoming.(*Object); ok {
+				existing.deepMerge(incoming, policy, issue)
+				continue
+			}
+		case *Text:
+			if incoming, ok := incoming.(*Text); ok && existing.Merge(incoming) == nil {
+				continue
+			}
+		}
+
+		if policy == PreferIncoming ||
+			(policy == PreferNewerTicket && incoming.CreatedAt().After(existing.CreatedAt())) {
+			o.Set(k, restamp(incoming, issue))
+		}
+	}
+}
+
+// restamp returns a copy of the live part of the given element with the
+// tickets issued by the given function.
+func restamp(elem Element, issue func() *time.Ticket) Element {
+	switch elem := elem.(type) {
+	case *Object:
+		obj := NewObject(NewElementRHT(), issue())
+		elem.ForEach(func(k string, child Element) bool {
+			obj.Set(k, restamp(child, issue))
+			return true
+		})
+		return obj
+	case *Array:
+		arr := NewArray(NewRGATreeList(), issue())
+		for _, child := range elem.Elements() {
+			arr.Add(restamp(child, issue))
+		}
+		return arr
+	case *Primitive:
+		return NewPrimitive(elem.Value(), issue())
+	case *Counter:
+		return NewCounter(elem.ValueType(), elem.Value(), issue())
+	case *Text:
+		text := elem.DeepCopy().(*Text)
+		text.createdAt = issue()
+		text.removedAt = nil
+		return text
+	}
+
+	panic("unsupported type")
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -39,4 +40,268 @@ func TestObject(t *testing.T) {
 		obj.Delete("k1", ctx.IssueTimeTicket())
 		assert.Equal(t, `{"k2":"v2"}`, obj.Marshal())
 	})
+
+	t.Run("for each test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		obj.Set("k3", crdt.NewPrimitive("v3", ctx.IssueTimeTicket()))
+		obj.Set("k1", crdt.NewPrimitive("v1", ctx.IssueTimeTicket()))
+		obj.Set("k2", crdt.NewPrimitive("v2", ctx.IssueTimeTicket()))
+		obj.Set("k4", crdt.NewPrimitive("v4", ctx.IssueTimeTicket()))
+		obj.Delete("k2", ctx.IssueTimeTicket())
+
+		var keys []string
+		obj.ForEach(func(key string, value crdt.Element) bool {
+			keys = append(keys, key+"="+value.Marshal())
+			return true
+		})
+		assert.Equal(t, []string{`k1="v1"`, `k3="v3"`, `k4="v4"`}, keys)
+
+		keys = nil
+		obj.ForEach(func(key string, value crdt.Element) bool {
+			keys = append(keys, key)
+			return key != "k3"
+		})
+		assert.Equal(t, []string{"k1", "k3"}, keys)
+	})
+
+	t.Run("structure as string test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		obj.Set("k2", crdt.NewPrimitive("v2", ctx.IssueTimeTicket()))
+		obj.Set("k1", crdt.NewPrimitive("v1", ctx.IssueTimeTicket()))
+		nested := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		nested.Set("n", crdt.NewPrimitive(1, ctx.IssueTimeTicket()))
+		obj.Set("k3", nested)
+		obj.Set("k1", crdt.NewPrimitive("v3", ctx.IssueTimeTicket()))
+		obj.Delete("k2", ctx.IssueTimeTicket())
+
+		assert.Equal(
+			t,
+			`({"k1" 0:3:00 "v1"}["k1" 0:6:00 "v3"]{"k2" 0:2:00 "v2"}["k3" 0:4:00 (["n" 0:5:00 1])])`,
+			obj.StructureAsString(),
+		)
+	})
+
+	t.Run("on change test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		var changes1, changes2 []string
+		obj.OnChange(func(change crdt.ObjectChange) {
+			value := "nil"
+			if change.Element != nil {
+				value = change.Element.Marshal()
+			}
+			changes1 = append(changes1, string(change.Type)+":"+change.Key+"="+value)
+		})
+		obj.OnChange(func(change crdt.ObjectChange) {
+			changes2 = append(changes2, string(change.Type)+":"+change.Key)
+		})
+
+		stale := crdt.NewPrimitive("stale", ctx.IssueTimeTicket())
+		obj.Set("k1", crdt.NewPrimitive("v1", ctx.IssueTimeTicket()))
+		v2 := crdt.NewPrimitive("v2", ctx.IssueTimeTicket())
+		obj.Set("k2", v2)
+		obj.Set("k1", crdt.NewPrimitive("v3", ctx.IssueTimeTicket()))
+
+		// NOTE: The element that lost to the newer one is not notified.
+		obj.Set("k1", stale)
+		assert.Equal(t, `{"k1":"v3","k2":"v2"}`, obj.Marshal())
+
+		obj.Delete("k1", ctx.IssueTimeTicket())
+		obj.DeleteByCreatedAt(v2.CreatedAt(), ctx.IssueTimeTicket())
+		obj.Delete("k3", ctx.IssueTimeTicket())
+		assert.Equal(t, `{}`, obj.Marshal())
+
+		assert.Equal(t, []string{
+			`set:k1="v1"`,
+			`set:k2="v2"`,
+			`set:k1="v3"`,
+			`delete:k1=nil`,
+			`delete:k2=nil`,
+		}, changes1)
+		assert.Equal(t, []string{"set:k1", "set:k2", "set:k1", "delete:k1", "delete:k2"}, changes2)
+	})
+
+	t.Run("deep merge test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		// the template is created before the objects merged into.
+		template := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		template.Set("a", crdt.NewPrimitive("template", ctx.IssueTimeTicket()))
+		nested := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		nested.Set("y", crdt.NewPrimitive("template", ctx.IssueTimeTicket()))
+		nested.Set("z", crdt.NewPrimitive("template", ctx.IssueTimeTicket()))
+		template.Set("n", nested)
+		template.Set("b", crdt.NewPrimitive("template", ctx.IssueTimeTicket()))
+
+		newObject := func() *crdt.Object {
+			obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+			obj.Set("a", crdt.NewPrimitive("existing", ctx.IssueTimeTicket()))
+			nested := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+			nested.Set("x", crdt.NewPrimitive("existing", ctx.IssueTimeTicket()))
+			nested.Set("y", crdt.NewPrimitive("existing", ctx.IssueTimeTicket()))
+			obj.Set("n", nested)
+			return obj
+		}
+
+		obj := newObject()
+		obj.DeepMerge(template, crdt.PreferExisting, ctx.IssueTimeTicket())
+		assert.Equal(
+			t,
+			`{"a":"existing","b":"template","n":{"x":"existing","y":"existing","z":"template"}}`,
+			obj.Marshal(),
+		)
+
+		obj = newObject()
+		obj.DeepMerge(template, crdt.PreferIncoming, ctx.IssueTimeTicket())
+		assert.Equal(
+			t,
+			`{"a":"template","b":"template","n":{"x":"existing","y":"template","z":"template"}}`,
+			obj.Marshal(),
+		)
+
+		// the values of the object are created after the template.
+		obj = newObject()
+		obj.DeepMerge(template, crdt.PreferNewerTicket, ctx.IssueTimeTicket())
+		assert.Equal(
+			t,
+			`{"a":"existing","b":"template","n":{"x":"existing","y":"existing","z":"template"}}`,
+			obj.Marshal(),
+		)
+		newer := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		newer.Set("a", crdt.NewPrimitive("newer", ctx.IssueTimeTicket()))
+		obj.DeepMerge(newer, crdt.PreferNewerTicket, ctx.IssueTimeTicket())
+		assert.Equal(
+			t,
+			`{"a":"newer","b":"template","n":{"x":"existing","y":"existing","z":"template"}}`,
+			obj.Marshal(),
+		)
+
+		// the copied values don't share the tickets with the template.
+		assert.NotEqual(t, template.Get("b").CreatedAt().Key(), obj.Get("b").CreatedAt().Key())
+	})
+
+	t.Run("deep merge texts test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		text := newTextWithContent(ctx, "Hello")
+		forked := text.DeepCopy().(*crdt.Text)
+		assert.NoError(t, text.Append(" World", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, forked.InsertAt(0, "> ", nil, ctx.IssueTimeTicket()))
+
+		obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		obj.Set("forked", text)
+		obj.Set("other", newTextWithContent(ctx, "Existing"))
+		incoming := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		incoming.Set("forked", forked)
+		incoming.Set("other", newTextWithContent(ctx, "Incoming"))
+
+		// the forked texts are merged, and the others are resolved by the policy.
+		obj.DeepMerge(incoming, crdt.PreferIncoming, ctx.IssueTimeTicket())
+		assert.Equal(t, "> Hello World", obj.Get("forked").(*crdt.Text).String())
+		assert.Equal(t, "Incoming", obj.Get("other").(*crdt.Text).String())
+	})
+
+	t.Run("len and raw len test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		obj := root.Object()
+		for _, k := range []string{"k1", "k2", "k3"} {
+			value := crdt.NewPrimitive(k, ctx.IssueTimeTicket())
+			obj.Set(k, value)
+			root.RegisterElement(value)
+		}
+		assert.Equal(t, 3, obj.Len())
+		assert.Equal(t, 3, obj.RawLen())
+
+		// the removed and the overwritten members are kept as tombstones.
+		deleted := obj.Delete("k2", ctx.IssueTimeTicket())
+		root.RegisterRemovedElementPair(obj, deleted)
+		overwritten := obj.Set("k3", crdt.NewPrimitive("v3", ctx.IssueTimeTicket()))
+		root.RegisterRemovedElementPair(obj, overwritten)
+		assert.Equal(t, []string{"k1", "k3"}, obj.Keys())
+		assert.Equal(t, 2, obj.Len())
+		assert.Len(t, obj.Members(), 2)
+		assert.Equal(t, 4, obj.RawLen())
+
+		// the tombstones are purged by GC.
+		assert.Equal(t, 2, root.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 2, obj.Len())
+		assert.Equal(t, 2, obj.RawLen())
+	})
+
+	t.Run("compare and set test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		obj := root.Object()
+
+		// 01. a nil ticket matches the key without a value.
+		removed, ok, err := obj.CompareAndSet("k1", nil, crdt.NewPrimitive("v1", ctx.IssueTimeTicket()))
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Nil(t, removed)
+		v1 := obj.Get("k1")
+
+		// 02. the value is set when the ticket of the current value matches.
+		removed, ok, err = obj.CompareAndSet("k1", v1.CreatedAt(), crdt.NewPrimitive("v2", ctx.IssueTimeTicket()))
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, v1, removed)
+		assert.Equal(t, `{"k1":"v2"}`, obj.Marshal())
+
+		// 03. the value is not set when the ticket doesn't match.
+		for _, expected := range []*time.Ticket{nil, v1.CreatedAt()} {
+			_, ok, err = obj.CompareAndSet("k1", expected, crdt.NewPrimitive("v3", ctx.IssueTimeTicket()))
+			assert.NoError(t, err)
+			assert.False(t, ok)
+		}
+		_, ok, err = obj.CompareAndSet("k2", v1.CreatedAt(), crdt.NewPrimitive("v3", ctx.IssueTimeTicket()))
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, `{"k1":"v2"}`, obj.Marshal())
+
+		// 04. the set before the current value is rejected.
+		v2 := obj.Get("k1")
+		_, ok, err = obj.CompareAndSet("k1", v2.CreatedAt(), crdt.NewPrimitive("v4", v1.CreatedAt()))
+		assert.ErrorIs(t, err, crdt.ErrOutdatedSet)
+		assert.False(t, ok)
+		assert.Equal(t, `{"k1":"v2"}`, obj.Marshal())
+	})
+
+	t.Run("clear test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		obj := root.Object()
+		obj.Set("k1", crdt.NewPrimitive("v1", ctx.IssueTimeTicket()))
+		obj.Set("k2", crdt.NewPrimitive("v2", ctx.IssueTimeTicket()))
+		obj.Delete("k2", ctx.IssueTimeTicket())
+		obj.Set("k3", crdt.NewPrimitive("v3", ctx.IssueTimeTicket()))
+		clearedAt := ctx.IssueTimeTicket()
+
+		// the value set concurrently with a newer ticket survives the clear,
+		// while the older values are removed.
+		obj.Set("k4", crdt.NewPrimitive("v4", ctx.IssueTimeTicket()))
+		removed := obj.Clear(clearedAt)
+		assert.Len(t, removed, 2)
+		assert.Equal(t, `{"k4":"v4"}`, obj.Marshal())
+		assert.Equal(t, 4, obj.RawLen())
+		assert.Empty(t, obj.Clear(clearedAt))
+
+		// the removed members registered to the root are collected by GC.
+		for _, elem := range removed {
+			root.RegisterRemovedElementPair(obj, elem)
+		}
+		assert.Equal(t, 2, root.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 2, obj.RawLen())
+	})
 }
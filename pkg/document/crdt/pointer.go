@@ -0,0 +1,133 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidPointer occurs when the given JSON Pointer is malformed.
+	ErrInvalidPointer = errors.New("invalid pointer")
+
+	// ErrElementNotFound occurs when the element that the given JSON Pointer
+	// refers to can't be found.
+	ErrElementNotFound = errors.New("element not found")
+)
+
+// Resolve returns the element that the given JSON Pointer(RFC 6901) refers
+// to, e.g. `/users/0/name`. The reference tokens are the keys of the objects
+// and the indexes of the arrays, where '~' and '/' in a key are escaped as
+// `~0` and `~1`. The empty pointer refers to the root object.
+func (r *Root) Resolve(pointer string) (Element, error) {
+	tokens, err := ParsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	return ResolveTokens(r.object, tokens)
+}
+
+// ParsePointer returns the unescaped reference tokens of the given JSON
+// Pointer.
+func ParsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("%s: %w", pointer, ErrInvalidPointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] == '~' && (j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1')) {
+				return nil, fmt.Errorf("%s: bad escape: %w", pointer, ErrInvalidPointer)
+			}
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// ResolveTokens returns the descendant of the given element that the given
+// reference tokens refer to.
+func ResolveTokens(elem Element, tokens []string) (Element, error) {
+	var err error
+	for i, token := range tokens {
+		if elem, err = resolveToken(elem, token); err != nil {
+			return nil, fmt.Errorf("%s: %w", formatPointer(tokens[:i+1]), err)
+		}
+	}
+
+	return elem, nil
+}
+
+// formatPointer returns the JSON Pointer of the given reference tokens.
+func formatPointer(tokens []string) string {
+	var sb strings.Builder
+	for _, token := range tokens {
+		sb.WriteString("/")
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+	return sb.String()
+}
+
+// resolveToken returns the child of the given element that the given
+// reference token refers to.
+func resolveToken(elem Element, token string) (Element, error) {
+	switch elem := elem.(type) {
+	case *Object:
+		child := elem.Get(token)
+		if child == nil {
+			return nil, ErrElementNotFound
+		}
+		return child, nil
+	case *Array:
+		idx, err := ParseArrayIndex(token)
+		if err != nil {
+			return nil, err
+		}
+		return elem.GetChecked(idx)
+	}
+
+	return nil, fmt.Errorf("%T is not a container: %w", elem, ErrElementNotFound)
+}
+
+// ParseArrayIndex returns the index of the given reference token. The index
+// must be a decimal number without leading zeros, and `-`, which refers to
+// the position after the last element, is out of range to resolve.
+func ParseArrayIndex(token string) (int, error) {
+	if token == "-" {
+		return 0, fmt.Errorf("index %s: %w", token, ErrOutOfRange)
+	}
+	if token == "" || (len(token) > 1 && token[0] == '0') ||
+		strings.IndexFunc(token, func(c rune) bool { return c < '0' || c > '9' }) >= 0 {
+		return 0, fmt.Errorf("index %q: %w", token, ErrInvalidPointer)
+	}
+
+	idx, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, fmt.Errorf("index %q: %w", token, ErrInvalidPointer)
+	}
+
+	return idx, nil
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestPointer(t *testing.T) {
+	// {"users":[{"name":"a"},{"name":"b"}],"a/b":{"m~n":1},"":"empty"}
+	root := helper.TestRoot()
+	ctx := helper.TextChangeContext(root)
+
+	users := crdt.NewArray(crdt.NewRGATreeList(), ctx.IssueTimeTicket())
+	for _, name := range []string{"a", "b"} {
+		user := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		user.Set("name", crdt.NewPrimitive(name, ctx.IssueTimeTicket()))
+		users.Add(user)
+	}
+	root.Object().Set("users", users)
+
+	escaped := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+	escaped.Set("m~n", crdt.NewPrimitive(1, ctx.IssueTimeTicket()))
+	root.Object().Set("a/b", escaped)
+	root.Object().Set("", crdt.NewPrimitive("empty", ctx.IssueTimeTicket()))
+
+	t.Run("resolve test", func(t *testing.T) {
+		for pointer, expected := range map[string]string{
+			"":              `{"":"empty","a/b":{"m~n":1},"users":[{"name":"a"},{"name":"b"}]}`,
+			"/users":        `[{"name":"a"},{"name":"b"}]`,
+			"/users/1":      `{"name":"b"}`,
+			"/users/0/name": `"a"`,
+			"/":             `"empty"`,
+		} {
+			elem, err := root.Resolve(pointer)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, elem.Marshal(), pointer)
+		}
+	})
+
+	t.Run("resolve escaped keys test", func(t *testing.T) {
+		elem, err := root.Resolve("/a~1b/m~0n")
+		assert.NoError(t, err)
+		assert.Equal(t, "1", elem.Marshal())
+
+		_, err = root.Resolve("/a/b")
+		assert.ErrorIs(t, err, crdt.ErrElementNotFound)
+		_, err = root.Resolve("/a~2b")
+		assert.ErrorIs(t, err, crdt.ErrInvalidPointer)
+		_, err = root.Resolve("/a~")
+		assert.ErrorIs(t, err, crdt.ErrInvalidPointer)
+	})
+
+	t.Run("resolve invalid array indexes test", func(t *testing.T) {
+		_, err := root.Resolve("/users/2")
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		_, err = root.Resolve("/users/-")
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+
+		for _, pointer := range []string{"/users/01", "/users/-1", "/users/+1", "/users/x", "users"} {
+			_, err = root.Resolve(pointer)
+			assert.ErrorIs(t, err, crdt.ErrInvalidPointer, pointer)
+		}
+
+		_, err = root.Resolve("/users/0/name/first")
+		assert.ErrorIs(t, err, crdt.ErrElementNotFound)
+		assert.ErrorContains(t, err, "/users/0/name/first")
+	})
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf16"
+)
+
+// ErrContentTooLarge is returned when the content of an edit exceeds the
+// limit of the policy.
+var ErrContentTooLarge = errors.New("content too large")
+
+// AttributeInheritance is the policy of which attributes the content
+// inserted without attributes inherits.
+type AttributeInheritance int
+
+const (
+	// InheritNone means that the inserted content has no attributes.
+	InheritNone AttributeInheritance = iota
+
+	// InheritLeft means that the inserted content inherits the attributes of
+	// the character before it, like typing at the end of a bold word.
+	InheritLeft
+
+	// InheritRight means that the inserted content inherits the attributes
+	// of the character after it.
+	InheritRight
+)
+
+// Policy is the policy of a document that limits the operations applied to
+// it, so that the server can reject the abusive operations, and configures
+// how the local edits are made.
+type Policy struct {
+	// MaxEditContentLen is the maximum length of the content inserted by an
+	// edit in UTF-16 code units. Zero means no limit.
+	MaxEditContentLen int
+
+	// AttributeInheritance is which attributes the content inserted by a
+	// local edit without attributes inherits. The inherited attributes are
+	// recorded in the operation, so the replicas converge regardless of
+	// their policies.
+	AttributeInheritance AttributeInheritance
+}
+
+// CheckEditContent returns an error if the length of the given content of an
+// edit exceeds the limit of this policy.
+func (p Policy) CheckEditContent(content string) error {
+	if p.MaxEditContentLen <= 0 {
+		return nil
+	}
+
+	if length := len(utf16.Encode([]rune(content))); length > p.MaxEditContentLen {
+		return fmt.Errorf("length %d over %d: %w", length, p.MaxEditContentLen, ErrContentTooLarge)
+	}
+
+	return nil
+}
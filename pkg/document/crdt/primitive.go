@@ -204,13 +204,13 @@ func (p *Primitive) Marshal() string {
 	case Long:
 		return fmt.Sprintf("%d", p.value)
 	case Double:
-		return fmt.Sprintf("%f", p.value)
+		return marshalDouble(p.value.(float64))
 	case String:
 		return fmt.Sprintf(`"%s"`, EscapeString(p.value.(string)))
 	case Bytes:
 		// TODO: JSON.stringify({a: new Uint8Array([1,2]), b: 2})
 		// {"a":{"0":1,"1":2},"b":2}
-		return fmt.Sprintf(`"%s"`, p.value)
+		return fmt.Sprintf(`"%s"`, EscapeString(string(p.value.([]byte))))
 	case Date:
 		return fmt.Sprintf(`"%s"`, p.value.(gotime.Time).Format(gotime.RFC3339))
 	}
@@ -218,6 +218,16 @@ func (p *Primitive) Marshal() string {
 	panic("unsupported type")
 }
 
+// marshalDouble returns the JSON encoding of the given double. NaN and
+// Infinity have no JSON representation, so they are encoded as null like
+// JSON.stringify does.
+func marshalDouble(value float64) string {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return "null"
+	}
+	return fmt.Sprintf("%f", value)
+}
+
 // DeepCopy copies itself deeply.
 func (p *Primitive) DeepCopy() Element {
 	primitive := *p
@@ -41,8 +41,10 @@ func TestPrimitive(t *testing.T) {
 		{int32(0), crdt.Integer, "0"},
 		{int64(0), crdt.Long, "0"},
 		{float64(0), crdt.Double, "0.000000"},
+		{math.Inf(1), crdt.Double, "null"},
 		{"0", crdt.String, `"0"`},
 		{[]byte{}, crdt.Bytes, `""`},
+		{[]byte(`"a"\`), crdt.Bytes, `"\"a\"\\"`},
 		{gotime.Unix(0, 0), crdt.Date, fmt.Sprintf(`"%s"`, gotime.Unix(0, 0).Format(gotime.RFC3339))},
 	}
 
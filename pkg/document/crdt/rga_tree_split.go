@@ -1,6 +1,7 @@
 package crdt
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,6 +13,13 @@ import (
 
 var (
 	initialNodeID = NewRGATreeSplitNodeID(time.InitialTicket, 0)
+
+	// ErrNodeNotFound is returned when the node of the given ID is not found.
+	ErrNodeNotFound = errors.New("node not found")
+
+	// ErrInvalidNodePos is returned when the given string is not an encoding
+	// of RGATreeSplitNodePos.
+	ErrInvalidNodePos = errors.New("invalid node pos")
 )
 
 // RGATreeSplitValue is a value of RGATreeSplitNode.
@@ -125,6 +133,49 @@ func (pos *RGATreeSplitNodePos) StructureAsString() string {
 	return fmt.Sprintf("%s:%d", pos.id.StructureAsString(), pos.relativeOffset)
 }
 
+// Marshal returns the string encoding of this position so that it can be
+// persisted, e.g. to restore the cursor of the editor. The format is
+// `LAMPORT:DELIMITER:ACTOR:OFFSET:RELATIVE_OFFSET`, and it is decoded by
+// ParseRGATreeSplitNodePos.
+func (pos *RGATreeSplitNodePos) Marshal() string {
+	return pos.id.key() + ":" + strconv.Itoa(pos.relativeOffset)
+}
+
+// ParseRGATreeSplitNodePos decodes the position encoded by
+// RGATreeSplitNodePos.Marshal.
+func ParseRGATreeSplitNodePos(encoded string) (*RGATreeSplitNodePos, error) {
+	parts := strings.Split(encoded, ":")
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("%s: %w", encoded, ErrInvalidNodePos)
+	}
+
+	lamport, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", encoded, ErrInvalidNodePos)
+	}
+	delimiter, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", encoded, ErrInvalidNodePos)
+	}
+	actorID, err := time.ActorIDFromHex(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", encoded, ErrInvalidNodePos)
+	}
+	offset, err := strconv.Atoi(parts[3])
+	if err != nil || offset < 0 {
+		return nil, fmt.Errorf("%s: %w", encoded, ErrInvalidNodePos)
+	}
+	relativeOffset, err := strconv.Atoi(parts[4])
+	if err != nil || relativeOffset < 0 {
+		return nil, fmt.Errorf("%s: %w", encoded, ErrInvalidNodePos)
+	}
+
+	return NewRGATreeSplitNodePos(
+		NewRGATreeSplitNodeID(time.NewTicket(lamport, uint32(delimiter), actorID), offset),
+		relativeOffset,
+	), nil
+}
+
 // ID returns the ID of this RGATreeSplitNodePos.
 func (pos *RGATreeSplitNodePos) ID() *RGATreeSplitNodeID {
 	return pos.id
@@ -208,6 +259,18 @@ func (s *RGATreeSplitNode[V]) Len() int {
 	return s.contentLen()
 }
 
+// Prev returns the previous node of this node in the list, or nil if this
+// node is the initial head.
+func (s *RGATreeSplitNode[V]) Prev() *RGATreeSplitNode[V] {
+	return s.prev
+}
+
+// Next returns the next node of this node in the list, or nil if this node is
+// the last node.
+func (s *RGATreeSplitNode[V]) Next() *RGATreeSplitNode[V] {
+	return s.next
+}
+
 // RemovedAt return the remove time of this node.
 func (s *RGATreeSplitNode[V]) RemovedAt() *time.Ticket {
 	return s.removedAt
@@ -266,13 +329,25 @@ func (s *RGATreeSplitNode[V]) structureAsString() string {
 }
 
 // Remove removes this node if it created before the time of deletion are
-// deleted. It only marks the deleted time (tombstone).
+// deleted. It only marks the deleted time (tombstone). It returns false if
+// the node has already been removed, so that the node is not removed from
+// the index tree twice.
 func (s *RGATreeSplitNode[V]) Remove(removedAt *time.Ticket, latestCreatedAt *time.Ticket) bool {
-	if !s.createdAt().After(latestCreatedAt) &&
-		(s.removedAt == nil || removedAt.After(s.removedAt)) {
+	if s.createdAt().After(latestCreatedAt) {
+		return false
+	}
+
+	if s.removedAt == nil {
 		s.removedAt = removedAt
 		return true
 	}
+
+	// NOTE: The node has been removed by a concurrent edit. The earliest
+	// removal is kept, so that the replicas converge regardless of the order
+	// in which the edits are applied.
+	if s.removedAt.After(removedAt) {
+		s.removedAt = removedAt
+	}
 	return false
 }
 
@@ -309,6 +384,11 @@ func NewRGATreeSplit[V RGATreeSplitValue](initialHead *RGATreeSplitNode[V]) *RGA
 	}
 }
 
+// length returns the total length of the live nodes.
+func (s *RGATreeSplit[V]) length() int {
+	return s.treeByIndex.Len()
+}
+
 func (s *RGATreeSplit[V]) createRange(from, to int) (*RGATreeSplitNodePos, *RGATreeSplitNodePos) {
 	fromPos := s.findNodePos(from)
 	if from == to {
@@ -327,22 +407,118 @@ func (s *RGATreeSplit[V]) findNodePos(index int) *RGATreeSplitNodePos {
 	}
 }
 
+// indexOf returns the integer offset of the given ID. The offset is
+// calculated with the weights of the index tree, so it takes O(log n) in
+// amortized time. If the node of the given ID has been removed, the offset
+// where the node was placed is returned.
+func (s *RGATreeSplit[V]) indexOf(id *RGATreeSplitNodeID) (int, error) {
+	// NOTE: If the node of the given ID has been purged by GC, the floor node
+	// can be a node of another insertion.
+	node := s.findFloorNode(id)
+	if node == nil || !node.id.hasSameCreatedAt(id) {
+		return 0, fmt.Errorf("%s: %w", id.StructureAsString(), ErrNodeNotFound)
+	}
+
+	// NOTE: If the part of the node containing the given ID has been purged
+	// by GC, the floor node doesn't contain the given ID.
+	relativeOffset := id.offset - node.id.offset
+	if relativeOffset > node.contentLen() {
+		return 0, fmt.Errorf("%s: %w", id.StructureAsString(), ErrNodeNotFound)
+	}
+
+	s.treeByIndex.Splay(node.indexNode)
+	index := s.treeByIndex.IndexOf(node.indexNode)
+	if node.removedAt != nil {
+		return index, nil
+	}
+
+	return index + relativeOffset, nil
+}
+
+// posIndexOf returns the integer offset of the given position. Unlike
+// indexOf, the position at the boundary of a split node is resolved to the end
+// of the left part like findNodeWithSplit does, so that the offsets of
+// positions can be compared before the nodes are split.
+func (s *RGATreeSplit[V]) posIndexOf(pos *RGATreeSplitNodePos) (int, error) {
+	node, relativeOffset, err := s.findPosNode(pos)
+	if err != nil {
+		return 0, err
+	}
+
+	s.treeByIndex.Splay(node.indexNode)
+	index := s.treeByIndex.IndexOf(node.indexNode)
+	if node.removedAt != nil {
+		return index, nil
+	}
+
+	return index + relativeOffset, nil
+}
+
+// findPosNode returns the node containing the given position and the offset
+// of the position in the node without splitting it. The position at the
+// boundary of the split nodes belongs to the left node.
+func (s *RGATreeSplit[V]) findPosNode(pos *RGATreeSplitNodePos) (*RGATreeSplitNode[V], int, error) {
+	id := pos.getAbsoluteID()
+	node := s.findFloorNode(id)
+	if node == nil || !node.id.hasSameCreatedAt(id) {
+		return nil, 0, fmt.Errorf("%s: %w", id.StructureAsString(), ErrNodeNotFound)
+	}
+	if id.offset > 0 && node.id.offset == id.offset && node.insPrev != nil {
+		node = node.insPrev
+	}
+
+	relativeOffset := id.offset - node.id.offset
+	if relativeOffset > node.contentLen() {
+		return nil, 0, fmt.Errorf("%s: %w", id.StructureAsString(), ErrNodeNotFound)
+	}
+
+	return node, relativeOffset, nil
+}
+
+// resolve returns the integer offset of the given position. Unlike indexOf,
+// it snaps to the nearest position if the part of the node containing the
+// position has been purged by GC: the end of the preceding part, or the start
+// of the following part of the same node. If the whole node has been purged,
+// it returns 0.
+func (s *RGATreeSplit[V]) resolve(pos *RGATreeSplitNodePos) int {
+	id := pos.getAbsoluteID()
+	if index, err := s.indexOf(id); err == nil {
+		return index
+	}
+
+	if node := s.findFloorNode(id); node != nil && node.id.hasSameCreatedAt(id) {
+		s.treeByIndex.Splay(node.indexNode)
+		return s.treeByIndex.IndexOf(node.indexNode) + node.Len()
+	}
+
+	for node := s.initialHead.next; node != nil; node = node.next {
+		if node.id.hasSameCreatedAt(id) && node.id.offset > id.offset {
+			s.treeByIndex.Splay(node.indexNode)
+			return s.treeByIndex.IndexOf(node.indexNode)
+		}
+	}
+
+	return 0
+}
+
 func (s *RGATreeSplit[V]) findNodeWithSplit(
 	pos *RGATreeSplitNodePos,
 	updatedAt *time.Ticket,
-) (*RGATreeSplitNode[V], *RGATreeSplitNode[V]) {
+) (*RGATreeSplitNode[V], *RGATreeSplitNode[V], error) {
 	absoluteID := pos.getAbsoluteID()
 	node := s.findFloorNodePreferToLeft(absoluteID)
 
 	relativeOffset := absoluteID.offset - node.id.offset
 
-	s.splitNode(node, relativeOffset)
+	if _, err := s.splitNode(node, relativeOffset); err != nil {
+		return nil, nil, err
+	}
 
 	for node.next != nil && node.next.createdAt().After(updatedAt) {
 		node = node.next
 	}
 
-	return node, node.next
+	return node, node.next, nil
 }
 
 func (s *RGATreeSplit[V]) findFloorNodePreferToLeft(id *RGATreeSplitNodeID) *RGATreeSplitNode[V] {
@@ -362,15 +538,19 @@ func (s *RGATreeSplit[V]) findFloorNodePreferToLeft(id *RGATreeSplitNodeID) *RGA
 	return node
 }
 
-func (s *RGATreeSplit[V]) splitNode(node *RGATreeSplitNode[V], offset int) *RGATreeSplitNode[V] {
-	if offset > node.contentLen() {
-		panic("offset should be less than or equal to length: " + s.StructureAsString())
+func (s *RGATreeSplit[V]) splitNode(node *RGATreeSplitNode[V], offset int) (*RGATreeSplitNode[V], error) {
+	if err := checkInvariant(
+		offset <= node.contentLen(),
+		"split %s at %d beyond length %d",
+		node.id.StructureAsString(), offset, node.contentLen(),
+	); err != nil {
+		return nil, err
 	}
 
 	if offset == 0 {
-		return node
+		return node, nil
 	} else if offset == node.contentLen() {
-		return node.next
+		return node.next, nil
 	}
 
 	splitNode := node.split(offset)
@@ -383,11 +563,22 @@ func (s *RGATreeSplit[V]) splitNode(node *RGATreeSplitNode[V], offset int) *RGAT
 	}
 	splitNode.SetInsPrev(node)
 
-	return splitNode
+	return splitNode, nil
 }
 
-// InsertAfter inserts the given node after the given previous node.
+// InsertAfter inserts the given node after the given previous node. If the
+// given node is a tombstone, such as a node split from a removed node or
+// a node copied from a snapshot, it is tracked to be purged by GC.
 func (s *RGATreeSplit[V]) InsertAfter(prev, node *RGATreeSplitNode[V]) *RGATreeSplitNode[V] {
+	if checkInvariant(prev != nil, "insert %s after nil", node.id.StructureAsString()) != nil ||
+		checkInvariant(node.prev == nil && node.next == nil, "insert linked %s", node.id.StructureAsString()) != nil {
+		return node
+	}
+	if strictMode.Load() {
+		key, _ := s.treeByID.Floor(node.id)
+		_ = checkInvariant(key == nil || !key.Equal(node.id), "insert duplicated %s", node.id.StructureAsString())
+	}
+
 	next := prev.next
 	node.setPrev(prev)
 	if next != nil {
@@ -396,6 +587,9 @@ func (s *RGATreeSplit[V]) InsertAfter(prev, node *RGATreeSplitNode[V]) *RGATreeS
 
 	s.treeByID.Put(node.id, node)
 	s.treeByIndex.InsertAfter(prev.indexNode, node.indexNode)
+	if node.removedAt != nil {
+		s.removedNodeMap[node.id.key()] = node
+	}
 
 	return node
 }
@@ -420,6 +614,32 @@ func (s *RGATreeSplit[V]) CheckWeight() bool {
 	return s.treeByIndex.CheckWeight()
 }
 
+// CheckLinks returns false when there is an inconsistent link between the
+// nodes, or the list and the index tree have the nodes in different order.
+// for debugging purpose.
+func (s *RGATreeSplit[V]) CheckLinks() bool {
+	if s.initialHead.prev != nil || s.treeByIndex.Last().Value().next != nil {
+		return false
+	}
+
+	size, offset := 0, 0
+	for node := s.initialHead; node != nil; node = node.next {
+		if node.next != nil && node.next.prev != node {
+			return false
+		}
+		if node.insNext != nil && node.insNext.insPrev != node {
+			return false
+		}
+		if s.treeByIndex.IndexOf(node.indexNode) != offset {
+			return false
+		}
+		size++
+		offset += node.Len()
+	}
+
+	return size == s.treeByIndex.Size()
+}
+
 func (s *RGATreeSplit[V]) findFloorNode(id *RGATreeSplitNodeID) *RGATreeSplitNode[V] {
 	key, value := s.treeByID.Floor(id)
 	if key == nil {
@@ -439,10 +659,16 @@ func (s *RGATreeSplit[V]) edit(
 	latestCreatedAtMapByActor map[string]*time.Ticket,
 	content V,
 	editedAt *time.Ticket,
-) (*RGATreeSplitNodePos, map[string]*time.Ticket) {
+) (*RGATreeSplitNodePos, map[string]*time.Ticket, error) {
 	// 01. Split nodes with from and to
-	toLeft, toRight := s.findNodeWithSplit(to, editedAt)
-	fromLeft, fromRight := s.findNodeWithSplit(from, editedAt)
+	toLeft, toRight, err := s.findNodeWithSplit(to, editedAt)
+	if err != nil {
+		return nil, nil, err
+	}
+	fromLeft, fromRight, err := s.findNodeWithSplit(from, editedAt)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// 02. delete between from and to
 	nodesToDelete := s.findBetween(fromRight, toRight)
@@ -467,7 +693,7 @@ func (s *RGATreeSplit[V]) edit(
 		s.removedNodeMap[key] = removedNode
 	}
 
-	return caretPos, latestCreatedAtMap
+	return caretPos, latestCreatedAtMap, nil
 }
 
 func (s *RGATreeSplit[V]) findBetween(from, to *RGATreeSplitNode[V]) []*RGATreeSplitNode[V] {
@@ -480,6 +706,20 @@ func (s *RGATreeSplit[V]) findBetween(from, to *RGATreeSplitNode[V]) []*RGATreeS
 	return nodes
 }
 
+// latestCreatedAtOf returns the creation time of the latest node of the given
+// actor that the editor of an operation has seen. If the map is nil, the
+// operation is a local one that has seen all the nodes.
+func latestCreatedAtOf(latestCreatedAtMapByActor map[string]*time.Ticket, actorIDHex string) *time.Ticket {
+	if latestCreatedAtMapByActor == nil {
+		return time.MaxTicket
+	}
+
+	if createdAt, ok := latestCreatedAtMapByActor[actorIDHex]; ok {
+		return createdAt
+	}
+	return time.InitialTicket
+}
+
 func (s *RGATreeSplit[V]) deleteNodes(
 	candidates []*RGATreeSplitNode[V],
 	latestCreatedAtMapByActor map[string]*time.Ticket,
@@ -501,18 +741,7 @@ func (s *RGATreeSplit[V]) deleteNodes(
 
 	for _, node := range candidates {
 		actorIDHex := node.createdAt().ActorIDHex()
-
-		var latestCreatedAt *time.Ticket
-		if latestCreatedAtMapByActor == nil {
-			latestCreatedAt = time.MaxTicket
-		} else {
-			createdAt, ok := latestCreatedAtMapByActor[actorIDHex]
-			if ok {
-				latestCreatedAt = createdAt
-			} else {
-				latestCreatedAt = time.InitialTicket
-			}
-		}
+		latestCreatedAt := latestCreatedAtOf(latestCreatedAtMapByActor, actorIDHex)
 
 		if node.Remove(editedAt, latestCreatedAt) {
 			latestCreatedAt := createdAtMapByActor[actorIDHex]
@@ -572,6 +801,12 @@ func (s *RGATreeSplit[V]) string() string {
 	return builder.String()
 }
 
+// lastNode returns the last node of the list, which is the initial head if
+// the list is empty.
+func (s *RGATreeSplit[V]) lastNode() *RGATreeSplitNode[V] {
+	return s.treeByIndex.Last().Value()
+}
+
 func (s *RGATreeSplit[V]) nodes() []*RGATreeSplitNode[V] {
 	var nodes []*RGATreeSplitNode[V]
 
@@ -602,22 +837,129 @@ func (s *RGATreeSplit[V]) StructureAsString() string {
 	return builder.String()
 }
 
+// merge integrates the nodes of the given RGATreeSplit into this
+// RGATreeSplit. The contents that exist in both are identified by their IDs
+// and merged with the given mergeValue, and the others are inserted by the
+// same rule as edit: the newer insertion is placed closer to its previous
+// node.
+func (s *RGATreeSplit[V]) merge(other *RGATreeSplit[V], mergeValue func(dst, src V)) error {
+	prev := s.initialHead
+	for node := other.initialHead.next; node != nil; node = node.next {
+		if floor := s.findFloorNode(node.id); floor != nil &&
+			node.id.offset < floor.id.offset+floor.contentLen() {
+			last, err := s.mergeNode(floor, node, mergeValue)
+			if err != nil {
+				return err
+			}
+			prev = last
+			continue
+		}
+
+		prev = s.insertCopyAfter(prev, node)
+	}
+
+	return nil
+}
+
+// mergeNode merges the given node of other RGATreeSplit into the nodes of
+// this RGATreeSplit that cover the same content, starting from the given
+// floor node. It returns the last node covering the content.
+func (s *RGATreeSplit[V]) mergeNode(
+	floor *RGATreeSplitNode[V],
+	node *RGATreeSplitNode[V],
+	mergeValue func(dst, src V),
+) (*RGATreeSplitNode[V], error) {
+	end := node.id.offset + node.contentLen()
+	current, err := s.splitNode(floor, node.id.offset-floor.id.offset)
+	if err != nil {
+		return nil, err
+	}
+
+	var last *RGATreeSplitNode[V]
+	for current != nil && current.id.offset < end {
+		if current.id.offset+current.contentLen() > end {
+			if _, err := s.splitNode(current, end-current.id.offset); err != nil {
+				return nil, err
+			}
+		}
+
+		mergeValue(current.value, node.value)
+		if node.removedAt != nil {
+			isAlive := current.removedAt == nil
+			if current.Remove(node.removedAt, time.MaxTicket) {
+				s.removedNodeMap[current.id.key()] = current
+			}
+			if isAlive {
+				s.treeByIndex.Splay(current.indexNode)
+				s.treeByIndex.UpdateWeight(current.indexNode)
+			}
+		}
+
+		last = current
+		current = current.insNext
+	}
+
+	return last, nil
+}
+
+// insertCopyAfter inserts the copy of the given node of other RGATreeSplit
+// after the given previous node and returns the inserted node.
+func (s *RGATreeSplit[V]) insertCopyAfter(prev, node *RGATreeSplitNode[V]) *RGATreeSplitNode[V] {
+	// NOTE: Only the beginning of an insertion is placed by the rule of
+	// concurrent insertions. The rest of it follows the previous node.
+	if node.id.offset == 0 {
+		for prev.next != nil && prev.next.createdAt().After(node.createdAt()) {
+			prev = prev.next
+		}
+	}
+
+	inserted := s.InsertAfter(prev, node.DeepCopy())
+	if node.id.offset > 0 {
+		insPrev := s.findFloorNode(NewRGATreeSplitNodeID(node.createdAt(), node.id.offset-1))
+		if insPrev != nil && insPrev != inserted {
+			inserted.SetInsPrev(insPrev)
+		}
+	}
+
+	return inserted
+}
+
 // removedNodesLen returns length of removed nodes
 func (s *RGATreeSplit[V]) removedNodesLen() int {
 	return len(s.removedNodeMap)
 }
 
-// purgeTextNodesWithGarbage physically purges nodes that have been removed.
-func (s *RGATreeSplit[V]) purgeTextNodesWithGarbage(ticket *time.Ticket) int {
-	count := 0
+// purgeTextNodesWithGarbage physically purges nodes that have been removed
+// and returns the purged nodes.
+func (s *RGATreeSplit[V]) purgeTextNodesWithGarbage(ticket *time.Ticket) []*RGATreeSplitNode[V] {
+	var purged []*RGATreeSplitNode[V]
 	for _, node := range s.removedNodeMap {
 		if node.removedAt != nil && ticket.Compare(node.removedAt) >= 0 {
 			s.treeByIndex.Delete(node.indexNode)
 			s.purge(node)
 			s.treeByID.Remove(node.id)
 			delete(s.removedNodeMap, node.id.key())
+			purged = append(purged, node)
+		}
+	}
+
+	return purged
+}
+
+// removeEmptyNodes physically removes the live nodes with empty content
+// except the initial head. The insertion links passing through the removed
+// nodes are rewired to their predecessors.
+func (s *RGATreeSplit[V]) removeEmptyNodes() int {
+	count := 0
+	for node := s.initialHead.next; node != nil; {
+		next := node.next
+		if node.removedAt == nil && node.contentLen() == 0 {
+			s.treeByIndex.Delete(node.indexNode)
+			s.purge(node)
+			s.treeByID.Remove(node.id)
 			count++
 		}
+		node = next
 	}
 
 	return count
@@ -625,6 +967,10 @@ func (s *RGATreeSplit[V]) purgeTextNodesWithGarbage(ticket *time.Ticket) int {
 
 // purge physically purge the given node from RGATreeSplit.
 func (s *RGATreeSplit[V]) purge(node *RGATreeSplitNode[V]) {
+	if checkInvariant(node.prev != nil, "purge unlinked %s", node.id.StructureAsString()) != nil {
+		return
+	}
+
 	node.prev.next = node.next
 	if node.next != nil {
 		node.next.prev = node.prev
@@ -17,25 +17,41 @@
 package crdt
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// ErrInvalidAttribute is returned when the given attribute can't be stored
+// in RHT, such as the one with an empty key or a value that is not valid
+// UTF-8.
+var ErrInvalidAttribute = errors.New("invalid attribute")
+
 // RHTNode is a node of RHT(Replicated Hashtable).
 type RHTNode struct {
-	key       string
-	val       string
+	key string
+	val string
+
+	// valueType is the type of the value. The value is stored as a string
+	// representation and valueType is used to restore the original type.
+	// It is one of String, Boolean, Integer, Long and Double.
+	valueType ValueType
+
 	updatedAt *time.Ticket
 	removedAt *time.Ticket
 }
 
-func newRHTNode(key, val string, updatedAt *time.Ticket) *RHTNode {
+func newRHTNode(key, val string, valueType ValueType, updatedAt *time.Ticket) *RHTNode {
 	return &RHTNode{
 		key:       key,
 		val:       val,
+		valueType: valueType,
 		updatedAt: updatedAt,
 	}
 }
@@ -56,11 +72,46 @@ func (n *RHTNode) Key() string {
 	return n.key
 }
 
-// Value returns the value of this node.
+// Value returns the string representation of the value of this node.
 func (n *RHTNode) Value() string {
 	return n.val
 }
 
+// ValueType returns the type of the value of this node.
+func (n *RHTNode) ValueType() ValueType {
+	return n.valueType
+}
+
+// TypedValue returns the value of this node with its original type.
+func (n *RHTNode) TypedValue() interface{} {
+	switch n.valueType {
+	case Boolean:
+		return n.val == "true"
+	case Integer:
+		val, _ := strconv.ParseInt(n.val, 10, 32)
+		return int32(val)
+	case Long:
+		val, _ := strconv.ParseInt(n.val, 10, 64)
+		return val
+	case Double:
+		val, _ := strconv.ParseFloat(n.val, 64)
+		return val
+	default:
+		return n.val
+	}
+}
+
+// marshal returns the JSON encoding of the value of this node. A typed value
+// whose representation is not a valid JSON literal of its type, such as NaN,
+// is encoded as a string so that the encoding is always valid JSON.
+func (n *RHTNode) marshal(policy EscapePolicy) string {
+	if n.valueType != String && isJSONLiteral(n.val, n.valueType) {
+		return n.val
+	}
+
+	return fmt.Sprintf(`"%s"`, EscapeStringWithPolicy(n.val, policy))
+}
+
 // UpdatedAt returns the last update time.
 func (n *RHTNode) UpdatedAt() *time.Ticket {
 	return n.updatedAt
@@ -75,12 +126,37 @@ func (n *RHTNode) RemovedAt() *time.Ticket {
 // For more details about RHT: http://csl.skku.edu/papers/jpdc11.pdf
 type RHT struct {
 	nodeMapByKey map[string]*RHTNode
+
+	// setMapByKey is the sets of the string values by key, whose values are
+	// added and removed individually.
+	setMapByKey map[string]*rhtSet
+
+	// conflictStats is the statistics of the conflicts. It is nil unless
+	// EnableConflictStats is called, so it costs nothing by default.
+	conflictStats *RHTConflictStats
+}
+
+// RHTConflictStats is the statistics of the conflicts resolved by the
+// tickets in RHT. It is for observing the contention on the keys.
+type RHTConflictStats struct {
+	// SetWins is the number of sets that overwrote the existing value.
+	SetWins int
+
+	// SetLoses is the number of sets that were ignored because the existing
+	// value is newer.
+	SetLoses int
+
+	// RemoveSetConflicts is the number of sets that were ignored because
+	// the key has been removed later, and of removals that are older than
+	// the last set of the key.
+	RemoveSetConflicts int
 }
 
 // NewRHT creates a new instance of RHT.
 func NewRHT() *RHT {
 	return &RHT{
 		nodeMapByKey: make(map[string]*RHTNode),
+		setMapByKey:  make(map[string]*rhtSet),
 	}
 }
 
@@ -105,17 +181,109 @@ func (rht *RHT) Has(key string) bool {
 	return false
 }
 
+// MetaOf returns the actor and the time of the set that won for the given
+// key. It returns false if the key doesn't exist or has been removed.
+func (rht *RHT) MetaOf(key string) (*time.ActorID, *time.Ticket, bool) {
+	node, ok := rht.nodeMapByKey[key]
+	if !ok || node.isRemoved() || node.updatedAt == nil {
+		return nil, nil, false
+	}
+
+	return node.updatedAt.ActorID(), node.updatedAt, true
+}
+
 // Set sets the value of the given key.
 func (rht *RHT) Set(k, v string, executedAt *time.Ticket) {
-	if node, ok := rht.nodeMapByKey[k]; !ok || executedAt.After(node.updatedAt) {
-		newNode := newRHTNode(k, v, executedAt)
+	rht.set(k, v, String, executedAt)
+}
+
+// SetValue sets the given typed value of the given key. Only string, boolean
+// and numeric values are allowed, and they are marshaled with their types. It
+// returns ErrInvalidAttribute for the other values, and for NaN and Inf which
+// can't be encoded in JSON.
+func (rht *RHT) SetValue(k string, v interface{}, executedAt *time.Ticket) error {
+	val, valueType, err := toRHTValue(v)
+	if err != nil {
+		return fmt.Errorf("key %q: %w", k, err)
+	}
+
+	rht.set(k, val, valueType, executedAt)
+	return nil
+}
+
+// SetWithType sets the given string representation of a value of the given
+// type, such as the one decoded from a snapshot. It returns
+// ErrInvalidAttribute if the type is not the one of the values of RHT.
+func (rht *RHT) SetWithType(k, v string, valueType ValueType, executedAt *time.Ticket) error {
+	switch valueType {
+	case String, Boolean, Integer, Long, Double:
+	default:
+		return fmt.Errorf("key %q of type %d: %w", k, valueType, ErrInvalidAttribute)
+	}
+
+	rht.set(k, v, valueType, executedAt)
+	return nil
+}
+
+// GetValue returns the typed value of the given key.
+func (rht *RHT) GetValue(key string) interface{} {
+	if node, ok := rht.nodeMapByKey[key]; ok && !node.isRemoved() {
+		return node.TypedValue()
+	}
+
+	return nil
+}
+
+// set sets the value of the given key. The value is not set if the given
+// time is not after the last update or the removal of the key, so that the
+// stale value can't resurrect the removed key.
+func (rht *RHT) set(k, v string, valueType ValueType, executedAt *time.Ticket) {
+	node, ok := rht.nodeMapByKey[k]
+	if !ok || (executedAt.After(node.updatedAt) &&
+		(node.removedAt == nil || executedAt.After(node.removedAt))) {
+		newNode := newRHTNode(k, v, valueType, executedAt)
 		rht.nodeMapByKey[k] = newNode
+
+		if ok && rht.conflictStats != nil {
+			rht.conflictStats.SetWins++
+		}
+		return
+	}
+
+	if rht.conflictStats != nil {
+		if executedAt.After(node.updatedAt) {
+			rht.conflictStats.RemoveSetConflicts++
+		} else {
+			rht.conflictStats.SetLoses++
+		}
+	}
+}
+
+// EnableConflictStats starts collecting the statistics of the conflicts.
+func (rht *RHT) EnableConflictStats() {
+	if rht.conflictStats == nil {
+		rht.conflictStats = &RHTConflictStats{}
 	}
 }
 
+// ConflictStats returns the statistics of the conflicts collected since
+// EnableConflictStats is called. It returns the zero value if it is not
+// enabled.
+func (rht *RHT) ConflictStats() RHTConflictStats {
+	if rht.conflictStats == nil {
+		return RHTConflictStats{}
+	}
+	return *rht.conflictStats
+}
+
 // Remove removes the Element of the given key.
 func (rht *RHT) Remove(k string, executedAt *time.Ticket) string {
-	if node, ok := rht.nodeMapByKey[k]; ok && executedAt.After(node.removedAt) {
+	if node, ok := rht.nodeMapByKey[k]; ok &&
+		(node.removedAt == nil || executedAt.After(node.removedAt)) {
+		if rht.conflictStats != nil && executedAt != nil &&
+			node.updatedAt != nil && node.updatedAt.After(executedAt) {
+			rht.conflictStats.RemoveSetConflicts++
+		}
 		node.Remove(executedAt)
 		return node.val
 	}
@@ -123,6 +291,48 @@ func (rht *RHT) Remove(k string, executedAt *time.Ticket) string {
 	return ""
 }
 
+// merge merges the given RHT into this RHT. The latest value of each key
+// wins, and the key is removed if it has been removed after the latest
+// value.
+func (rht *RHT) merge(other *RHT) {
+	for _, node := range other.nodeMapByKey {
+		current, ok := rht.nodeMapByKey[node.key]
+		if !ok {
+			rht.nodeMapByKey[node.key] = &RHTNode{
+				key:       node.key,
+				val:       node.val,
+				valueType: node.valueType,
+				updatedAt: node.updatedAt,
+				removedAt: node.removedAt,
+			}
+			continue
+		}
+
+		merged := *current
+		if node.updatedAt.After(current.updatedAt) {
+			merged.val = node.val
+			merged.valueType = node.valueType
+			merged.updatedAt = node.updatedAt
+		}
+		if node.removedAt != nil &&
+			(merged.removedAt == nil || node.removedAt.After(merged.removedAt)) {
+			merged.removedAt = node.removedAt
+		}
+		if merged.removedAt != nil && !merged.removedAt.After(merged.updatedAt) {
+			merged.removedAt = nil
+		}
+		rht.nodeMapByKey[node.key] = &merged
+	}
+
+	for k, set := range other.setMapByKey {
+		if current, ok := rht.setMapByKey[k]; ok {
+			current.merge(set)
+		} else {
+			rht.setMapByKey[k] = set.deepCopy()
+		}
+	}
+}
+
 // Elements returns a map of elements because the map easy to use for loop.
 // TODO: If we encounter performance issues, we need to replace this with other solution.
 func (rht *RHT) Elements() map[string]string {
@@ -147,19 +357,100 @@ func (rht *RHT) Nodes() []*RHTNode {
 	return nodes
 }
 
-// DeepCopy copies itself deeply.
+// DeepCopy copies itself deeply. The tombstones are copied with their
+// removal time so that the removed keys are not resurrected by stale sets.
 func (rht *RHT) DeepCopy() *RHT {
 	instance := NewRHT()
 
 	for _, node := range rht.Nodes() {
-		instance.Set(node.key, node.val, node.updatedAt)
+		instance.nodeMapByKey[node.key] = &RHTNode{
+			key:       node.key,
+			val:       node.val,
+			valueType: node.valueType,
+			updatedAt: node.updatedAt,
+			removedAt: node.removedAt,
+		}
+	}
+	for k, set := range rht.setMapByKey {
+		instance.setMapByKey[k] = set.deepCopy()
+	}
+	return instance
+}
+
+// copyAsOf copies the nodes of this hashtable that were live at the given
+// ticket. The nodes updated after the ticket are dropped, because the values
+// they have overwritten are not kept.
+func (rht *RHT) copyAsOf(ticket *time.Ticket) *RHT {
+	instance := NewRHT()
+
+	for _, node := range rht.Nodes() {
+		if node.updatedAt.After(ticket) || (node.removedAt != nil && !node.removedAt.After(ticket)) {
+			continue
+		}
+		instance.nodeMapByKey[node.key] = &RHTNode{
+			key:       node.key,
+			val:       node.val,
+			valueType: node.valueType,
+			updatedAt: node.updatedAt,
+		}
+	}
+	for k, set := range rht.setMapByKey {
+		instance.setMapByKey[k] = set.copyAsOf(ticket)
+	}
+	return instance
+}
+
+// liveCopy copies the nodes of this hashtable that are not removed.
+func (rht *RHT) liveCopy() *RHT {
+	instance := NewRHT()
+
+	for _, node := range rht.Nodes() {
+		if node.isRemoved() {
+			continue
+		}
+		instance.nodeMapByKey[node.key] = &RHTNode{
+			key:       node.key,
+			val:       node.val,
+			valueType: node.valueType,
+			updatedAt: node.updatedAt,
+		}
+	}
+	for k, set := range rht.setMapByKey {
+		instance.setMapByKey[k] = set.copyAsOf(nil)
 	}
 	return instance
 }
 
 // Marshal returns the JSON encoding of this hashtable.
 func (rht *RHT) Marshal() string {
-	members := rht.Elements()
+	return rht.MarshalWithPolicy(EscapeJSON)
+}
+
+// MarshalWithPolicy returns the JSON encoding of this hashtable with the given
+// escape policy.
+func (rht *RHT) MarshalWithPolicy(policy EscapePolicy) string {
+	return rht.marshal(policy, nil)
+}
+
+// marshal returns the JSON encoding of this hashtable with the given escape
+// policy, omitting the members whose values are the same as the given
+// default ones.
+func (rht *RHT) marshal(policy EscapePolicy, defaults map[string]string) string {
+	members := make(map[string]string)
+	for k, set := range rht.setMapByKey {
+		if len(set.tagsByValue) > 0 {
+			members[k] = set.marshal(policy)
+		}
+	}
+	for _, node := range rht.nodeMapByKey {
+		if node.isRemoved() {
+			continue
+		}
+		if value, ok := defaults[node.key]; ok && value == node.val {
+			continue
+		}
+		members[node.key] = node.marshal(policy)
+	}
 
 	size := len(members)
 
@@ -176,10 +467,79 @@ func (rht *RHT) Marshal() string {
 		if idx > 0 {
 			sb.WriteString(",")
 		}
-		value := members[k]
-		sb.WriteString(fmt.Sprintf(`"%s":"%s"`, EscapeString(k), EscapeString(value)))
+		sb.WriteString(fmt.Sprintf(`"%s":%s`, EscapeStringWithPolicy(k, policy), members[k]))
 	}
 	sb.WriteString("}")
 
 	return sb.String()
 }
+
+// validateAttrs validates the given attributes before any of them is set, so
+// that the attributes can be applied all together or not at all. The keys are
+// validated in order, so the reported key is deterministic.
+func validateAttrs(attrs map[string]string) error {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if k == "" || !utf8.ValidString(k) {
+			return fmt.Errorf("key %q: %w", k, ErrInvalidAttribute)
+		}
+		if !utf8.ValidString(attrs[k]) {
+			return fmt.Errorf("value of key %q: %w", k, ErrInvalidAttribute)
+		}
+	}
+
+	return nil
+}
+
+// isJSONLiteral returns whether the given representation is the canonical
+// form of the given type produced by toRHTValue, which is a valid JSON
+// literal.
+func isJSONLiteral(val string, valueType ValueType) bool {
+	switch valueType {
+	case Boolean:
+		return val == "true" || val == "false"
+	case Integer, Long:
+		i, err := strconv.ParseInt(val, 10, 64)
+		return err == nil && val == strconv.FormatInt(i, 10)
+	case Double:
+		f, err := strconv.ParseFloat(val, 64)
+		return err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) &&
+			val == strconv.FormatFloat(f, 'g', -1, 64)
+	}
+
+	return false
+}
+
+// toRHTValue converts the given value to the string representation and the
+// type of the value.
+func toRHTValue(v interface{}) (string, ValueType, error) {
+	switch val := v.(type) {
+	case string:
+		return val, String, nil
+	case bool:
+		return strconv.FormatBool(val), Boolean, nil
+	case int32:
+		return strconv.FormatInt(int64(val), 10), Integer, nil
+	case int64:
+		return strconv.FormatInt(val, 10), Long, nil
+	case int:
+		if val > math.MaxInt32 || val < math.MinInt32 {
+			return strconv.FormatInt(int64(val), 10), Long, nil
+		}
+		return strconv.FormatInt(int64(val), 10), Integer, nil
+	case float32:
+		return toRHTValue(float64(val))
+	case float64:
+		if math.IsNaN(val) || math.IsInf(val, 0) {
+			return "", 0, fmt.Errorf("value %v can't be encoded in JSON: %w", val, ErrInvalidAttribute)
+		}
+		return strconv.FormatFloat(val, 'g', -1, 64), Double, nil
+	}
+
+	return "", 0, fmt.Errorf("value of %T: %w", v, ErrInvalidAttribute)
+}
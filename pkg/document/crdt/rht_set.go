@@ -0,0 +1,207 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// rhtSet is an add-wins set of the string values of a key of RHT, such as a
+// list of CSS classes. Each addition of a value is tagged with its time, and
+// a removal removes only the tags it has observed, so the value added
+// concurrently with the removal survives.
+type rhtSet struct {
+	tagsByValue map[string]map[string]*time.Ticket
+
+	// removedTags is the set of the removed tags. It is kept so that the
+	// addition delivered after its removal is not resurrected.
+	removedTags map[string]bool
+}
+
+func newRHTSet() *rhtSet {
+	return &rhtSet{
+		tagsByValue: make(map[string]map[string]*time.Ticket),
+		removedTags: make(map[string]bool),
+	}
+}
+
+// add adds the given value tagged with the given time.
+func (s *rhtSet) add(v string, addedAt *time.Ticket) {
+	if s.removedTags[addedAt.Key()] {
+		return
+	}
+
+	tags, ok := s.tagsByValue[v]
+	if !ok {
+		tags = make(map[string]*time.Ticket)
+		s.tagsByValue[v] = tags
+	}
+	tags[addedAt.Key()] = addedAt
+}
+
+// remove removes the given tags of the given value, or all the observed tags
+// of the value if tags is nil, and returns the removed tags.
+func (s *rhtSet) remove(v string, tags []*time.Ticket) []*time.Ticket {
+	if tags == nil {
+		for _, tag := range s.tagsByValue[v] {
+			tags = append(tags, tag)
+		}
+		sort.Slice(tags, func(i, j int) bool {
+			return tags[j].After(tags[i])
+		})
+	}
+
+	for _, tag := range tags {
+		s.removedTags[tag.Key()] = true
+		delete(s.tagsByValue[v], tag.Key())
+	}
+	if len(s.tagsByValue[v]) == 0 {
+		delete(s.tagsByValue, v)
+	}
+
+	return tags
+}
+
+// members returns the values that have live tags in the order of their
+// first additions, so that the order is the same in all the replicas.
+func (s *rhtSet) members() []string {
+	firstAddedAt := make(map[string]*time.Ticket)
+	var values []string
+	for v, tags := range s.tagsByValue {
+		for _, tag := range tags {
+			if first, ok := firstAddedAt[v]; !ok || first.After(tag) {
+				firstAddedAt[v] = tag
+			}
+		}
+		values = append(values, v)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return firstAddedAt[values[j]].After(firstAddedAt[values[i]])
+	})
+	return values
+}
+
+// merge merges the given set into this set. The tags are united, and the
+// ones removed in either set are removed.
+func (s *rhtSet) merge(other *rhtSet) {
+	for tag := range other.removedTags {
+		s.removedTags[tag] = true
+	}
+	for v, tags := range other.tagsByValue {
+		for _, tag := range tags {
+			s.add(v, tag)
+		}
+	}
+	for v, tags := range s.tagsByValue {
+		for key := range tags {
+			if s.removedTags[key] {
+				delete(tags, key)
+			}
+		}
+		if len(tags) == 0 {
+			delete(s.tagsByValue, v)
+		}
+	}
+}
+
+// copyAsOf copies the tags of this set added at or before the given ticket.
+// Like the overwritten values of RHT, the removal times of the tags are not
+// kept, so the tags removed after the ticket are not restored. If the ticket
+// is nil, all the live tags are copied.
+func (s *rhtSet) copyAsOf(ticket *time.Ticket) *rhtSet {
+	instance := newRHTSet()
+	for v, tags := range s.tagsByValue {
+		for _, tag := range tags {
+			if ticket == nil || !tag.After(ticket) {
+				instance.add(v, tag)
+			}
+		}
+	}
+
+	return instance
+}
+
+// deepCopy copies itself deeply including the removed tags.
+func (s *rhtSet) deepCopy() *rhtSet {
+	instance := s.copyAsOf(nil)
+	for tag := range s.removedTags {
+		instance.removedTags[tag] = true
+	}
+
+	return instance
+}
+
+// marshal returns the JSON encoding of the members of this set.
+func (s *rhtSet) marshal(policy EscapePolicy) string {
+	sb := strings.Builder{}
+	sb.WriteString("[")
+	for idx, v := range s.members() {
+		if idx > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(fmt.Sprintf(`"%s"`, EscapeStringWithPolicy(v, policy)))
+	}
+	sb.WriteString("]")
+
+	return sb.String()
+}
+
+// AddToSet adds the given value to the set of the given key, such as a class
+// of a list of CSS classes. The set is an add-wins set: the value added
+// concurrently with its removal survives. The key of a set should not be
+// used for a string value, which takes precedence over the set in Marshal.
+func (rht *RHT) AddToSet(k, v string, executedAt *time.Ticket) {
+	set, ok := rht.setMapByKey[k]
+	if !ok {
+		set = newRHTSet()
+		rht.setMapByKey[k] = set
+	}
+
+	set.add(v, executedAt)
+}
+
+// RemoveFromSet removes the given value from the set of the given key. Only
+// the additions of the given tags are removed, or all the additions observed
+// by this hashtable if tags is nil, like a local removal. It returns the
+// removed tags, which are to be removed from the other replicas.
+func (rht *RHT) RemoveFromSet(k, v string, tags []*time.Ticket) []*time.Ticket {
+	set, ok := rht.setMapByKey[k]
+	if !ok {
+		if tags == nil {
+			return nil
+		}
+		set = newRHTSet()
+		rht.setMapByKey[k] = set
+	}
+
+	return set.remove(v, tags)
+}
+
+// SetMembers returns the values of the set of the given key in the order of
+// their first additions.
+func (rht *RHT) SetMembers(k string) []string {
+	if set, ok := rht.setMapByKey[k]; ok {
+		return set.members()
+	}
+
+	return nil
+}
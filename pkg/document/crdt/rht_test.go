@@ -1,9 +1,12 @@
 package crdt
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
 func TestMarshal(t *testing.T) {
@@ -20,4 +23,159 @@ func TestMarshal(t *testing.T) {
 		actual := rht.Marshal()
 		assert.Equal(t, expected, actual)
 	})
+
+	t.Run("marshal typed value test", func(t *testing.T) {
+		rht := NewRHT()
+		rht.Set("color", "red", nil)
+		assert.NoError(t, rht.SetValue("fontSize", 14, nil))
+		assert.NoError(t, rht.SetValue("ratio", 1.5, nil))
+		assert.NoError(t, rht.SetValue("checked", true, nil))
+		assert.NoError(t, rht.SetValue("title", `"quoted"`, nil))
+		assert.Equal(
+			t,
+			`{"checked":true,"color":"red","fontSize":14,"ratio":1.5,"title":"\"quoted\""}`,
+			rht.Marshal(),
+		)
+
+		assert.Equal(t, int32(14), rht.GetValue("fontSize"))
+		assert.Equal(t, 1.5, rht.GetValue("ratio"))
+		assert.Equal(t, true, rht.GetValue("checked"))
+		assert.Equal(t, "14", rht.Get("fontSize"))
+		assert.Nil(t, rht.GetValue("none"))
+		assert.Equal(t, rht.Marshal(), rht.DeepCopy().Marshal())
+		assert.ErrorIs(t, rht.SetValue("invalid", []int{1}, nil), ErrInvalidAttribute)
+		assert.ErrorIs(t, rht.SetValue("invalid", math.NaN(), nil), ErrInvalidAttribute)
+		assert.ErrorIs(t, rht.SetValue("invalid", math.Inf(1), nil), ErrInvalidAttribute)
+		assert.False(t, rht.Has("invalid"))
+	})
+
+	t.Run("deep copy with tombstone test", func(t *testing.T) {
+		rht := NewRHT()
+		rht.Set("k", "v1", time.NewTicket(1, 0, time.InitialActorID))
+		rht.Remove("k", time.NewTicket(3, 0, time.InitialActorID))
+		assert.False(t, rht.Has("k"))
+
+		clone := rht.DeepCopy()
+		assert.Len(t, clone.Nodes(), 1)
+		assert.Equal(t, rht.Nodes()[0].RemovedAt(), clone.Nodes()[0].RemovedAt())
+
+		// A stale set older than the tombstone should not resurrect the key.
+		clone.Set("k", "v2", time.NewTicket(2, 0, time.InitialActorID))
+		assert.False(t, clone.Has("k"))
+		assert.Equal(t, "{}", clone.Marshal())
+
+		clone.Set("k", "v3", time.NewTicket(4, 0, time.InitialActorID))
+		assert.Equal(t, "v3", clone.Get("k"))
+		assert.False(t, rht.Has("k"))
+	})
+
+	t.Run("conflict stats test", func(t *testing.T) {
+		actorID, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		ticket := func(lamport int64) *time.Ticket {
+			return time.NewTicket(lamport, 0, actorID)
+		}
+
+		rht := NewRHT()
+		rht.Set("k1", "v1", ticket(1))
+		assert.Equal(t, RHTConflictStats{}, rht.ConflictStats())
+
+		rht.EnableConflictStats()
+		rht.Set("k1", "v2", ticket(3))
+		rht.Set("k1", "v3", ticket(2))
+		rht.Remove("k1", ticket(5))
+		rht.Set("k1", "v4", ticket(4))
+		rht.Set("k2", "v1", ticket(7))
+		rht.Remove("k2", ticket(6))
+
+		assert.Equal(t, RHTConflictStats{
+			SetWins:            1,
+			SetLoses:           1,
+			RemoveSetConflicts: 2,
+		}, rht.ConflictStats())
+		assert.Equal(t, "{}", rht.Marshal())
+	})
+
+	t.Run("meta of test", func(t *testing.T) {
+		actor1, _ := time.ActorIDFromHex("000000000000000000000001")
+		actor2, _ := time.ActorIDFromHex("000000000000000000000002")
+
+		rht := NewRHT()
+		_, _, ok := rht.MetaOf("k1")
+		assert.False(t, ok)
+
+		rht.Set("k1", "v1", time.NewTicket(1, 0, actor1))
+		rht.Set("k1", "v2", time.NewTicket(3, 0, actor2))
+		rht.Set("k1", "v3", time.NewTicket(2, 0, actor1))
+		actor, ticket, ok := rht.MetaOf("k1")
+		assert.True(t, ok)
+		assert.Equal(t, "v2", rht.Get("k1"))
+		assert.Equal(t, actor2, actor)
+		assert.Equal(t, int64(3), ticket.Lamport())
+
+		rht.Remove("k1", time.NewTicket(4, 0, actor1))
+		_, _, ok = rht.MetaOf("k1")
+		assert.False(t, ok)
+	})
+
+	t.Run("set attribute test", func(t *testing.T) {
+		rht := NewRHT()
+		rht.Set("color", "red", time.NewTicket(1, 0, time.InitialActorID))
+		rht.AddToSet("classes", "mark", time.NewTicket(3, 0, time.InitialActorID))
+		rht.AddToSet("classes", "bold", time.NewTicket(2, 0, time.InitialActorID))
+		rht.AddToSet("classes", "mark", time.NewTicket(4, 0, time.InitialActorID))
+		assert.Equal(t, []string{"bold", "mark"}, rht.SetMembers("classes"))
+		assert.Equal(t, `{"classes":["bold","mark"],"color":"red"}`, rht.Marshal())
+		assert.Equal(t, rht.Marshal(), rht.DeepCopy().Marshal())
+
+		// 01. the removal removes all the observed additions of the value.
+		assert.Len(t, rht.RemoveFromSet("classes", "mark", nil), 2)
+		assert.Equal(t, `{"classes":["bold"],"color":"red"}`, rht.Marshal())
+		rht.RemoveFromSet("classes", "bold", nil)
+		assert.Equal(t, `{"color":"red"}`, rht.Marshal())
+
+		// 02. the set with members is not empty.
+		value := NewTextValue("a", NewRHT())
+		value.attrs.AddToSet("classes", "bold", time.NewTicket(1, 0, time.InitialActorID))
+		assert.Equal(t, `{"attrs":{"classes":["bold"]},"val":"a"}`, value.Marshal())
+	})
+
+	t.Run("concurrent set add and remove test", func(t *testing.T) {
+		actorA, _ := time.ActorIDFromHex("000000000000000000000001")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000002")
+
+		base := NewRHT()
+		base.AddToSet("classes", "bold", time.NewTicket(1, 0, actorA))
+		rhtA, rhtB := base.DeepCopy(), base.DeepCopy()
+
+		// 01. A removes bold while B adds bold again and adds mark.
+		removed := rhtA.RemoveFromSet("classes", "bold", nil)
+		assert.Len(t, removed, 1)
+		assert.Equal(t, time.NewTicket(1, 0, actorA).Key(), removed[0].Key())
+		rhtB.AddToSet("classes", "bold", time.NewTicket(2, 0, actorB))
+		rhtB.AddToSet("classes", "mark", time.NewTicket(3, 0, actorB))
+
+		// 02. the replicas exchange the operations and the addition wins.
+		rhtA.AddToSet("classes", "bold", time.NewTicket(2, 0, actorB))
+		rhtA.AddToSet("classes", "mark", time.NewTicket(3, 0, actorB))
+		rhtB.RemoveFromSet("classes", "bold", removed)
+		assert.Equal(t, []string{"bold", "mark"}, rhtA.SetMembers("classes"))
+		assert.Equal(t, rhtA.Marshal(), rhtB.Marshal())
+
+		// 03. the removal delivered before the addition it has observed
+		// prevents the addition from being resurrected.
+		rhtC := NewRHT()
+		rhtC.RemoveFromSet("classes", "bold", removed)
+		rhtC.AddToSet("classes", "bold", time.NewTicket(1, 0, actorA))
+		assert.Empty(t, rhtC.SetMembers("classes"))
+		rhtC.AddToSet("classes", "bold", time.NewTicket(2, 0, actorB))
+		rhtC.AddToSet("classes", "mark", time.NewTicket(3, 0, actorB))
+		assert.Equal(t, rhtA.Marshal(), rhtC.Marshal())
+
+		// 04. merging the replicas converges.
+		merged := base.DeepCopy()
+		merged.merge(rhtA)
+		merged.merge(rhtB)
+		assert.Equal(t, rhtA.Marshal(), merged.Marshal())
+	})
 }
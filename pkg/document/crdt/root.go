@@ -40,6 +40,19 @@ type Root struct {
 	elementMapByCreatedAt                map[string]Element
 	removedElementPairMapByCreatedAt     map[string]ElementPair
 	textElementWithGarbageMapByCreatedAt map[string]TextElement
+	textWithSelectionMapByCreatedAt      map[string]*Text
+
+	// version is the version vector of the operations applied to this root.
+	// It is also used to skip the operations delivered again, because some
+	// operations such as Increase are not idempotent.
+	version Version
+
+	// policy is the policy that limits the operations applied to this root.
+	policy Policy
+
+	// ticketGenerator issues the tickets of the local operations. If it is
+	// nil, time.LamportTicketGenerator is used.
+	ticketGenerator time.TicketGenerator
 }
 
 // NewRoot creates a new instance of Root.
@@ -48,19 +61,20 @@ func NewRoot(root *Object) *Root {
 		elementMapByCreatedAt:                make(map[string]Element),
 		removedElementPairMapByCreatedAt:     make(map[string]ElementPair),
 		textElementWithGarbageMapByCreatedAt: make(map[string]TextElement),
+		textWithSelectionMapByCreatedAt:      make(map[string]*Text),
+		version:                              make(Version),
 	}
 
 	r.object = root
-	r.RegisterElement(root)
-
+	elements := []Element{root}
 	root.Descendants(func(elem Element, parent Container) bool {
-		r.RegisterElement(elem)
+		elements = append(elements, elem)
 		if elem.RemovedAt() != nil {
 			r.RegisterRemovedElementPair(parent, elem)
 		}
-		// TODO(hackerwins): Register text elements with garbage
 		return false
 	})
+	r.RegisterElements(elements)
 
 	return r
 }
@@ -70,14 +84,46 @@ func (r *Root) Object() *Object {
 	return r.object
 }
 
+// Marshal returns the JSON encoding of the whole document from the root
+// object. The members of the objects are ordered by their keys.
+func (r *Root) Marshal() string {
+	return r.object.Marshal()
+}
+
 // FindByCreatedAt returns the element of given creation time.
 func (r *Root) FindByCreatedAt(createdAt *time.Ticket) Element {
 	return r.elementMapByCreatedAt[createdAt.Key()]
 }
 
-// RegisterElement registers the given element to hash table.
+// RegisterElement registers the given element to hash table. If the given
+// element is a text with tombstones or selections, it is also registered to
+// be collected.
 func (r *Root) RegisterElement(elem Element) {
 	r.elementMapByCreatedAt[elem.CreatedAt().Key()] = elem
+	if text, ok := elem.(TextElement); ok && text.removedNodesLen() > 0 {
+		r.RegisterTextElementWithGarbage(text)
+	}
+	if text, ok := elem.(*Text); ok && len(text.selectionMap) > 0 {
+		r.RegisterTextWithSelection(text)
+	}
+}
+
+// RegisterElements registers the given elements to hash table like
+// RegisterElement. The table is grown once for all the elements instead of
+// being grown repeatedly while they are registered one by one, such as when
+// a document is loaded from a snapshot.
+func (r *Root) RegisterElements(elements []Element) {
+	if len(elements) > len(r.elementMapByCreatedAt) {
+		elementMap := make(map[string]Element, len(r.elementMapByCreatedAt)+len(elements))
+		for key, elem := range r.elementMapByCreatedAt {
+			elementMap[key] = elem
+		}
+		r.elementMapByCreatedAt = elementMap
+	}
+
+	for _, elem := range elements {
+		r.RegisterElement(elem)
+	}
 }
 
 // DeregisterElement deregister the given element from hash tables.
@@ -85,6 +131,8 @@ func (r *Root) DeregisterElement(elem Element) {
 	createdAt := elem.CreatedAt().Key()
 	delete(r.elementMapByCreatedAt, createdAt)
 	delete(r.removedElementPairMapByCreatedAt, createdAt)
+	delete(r.textElementWithGarbageMapByCreatedAt, createdAt)
+	delete(r.textWithSelectionMapByCreatedAt, createdAt)
 }
 
 // RegisterRemovedElementPair register the given element pair to hash table.
@@ -100,12 +148,74 @@ func (r *Root) RegisterTextElementWithGarbage(textType TextElement) {
 	r.textElementWithGarbageMapByCreatedAt[textType.CreatedAt().Key()] = textType
 }
 
+// RegisterTextWithSelection register the given text with selections to hash
+// table, so that its stale selections are evicted by GarbageCollect.
+func (r *Root) RegisterTextWithSelection(text *Text) {
+	r.textWithSelectionMapByCreatedAt[text.CreatedAt().Key()] = text
+}
+
+// HasApplied returns whether the change of the operation executed at the
+// given time has been applied to this root or not. The changes of an actor
+// are applied in the order of their Lamport timestamps, so the change has
+// been applied if the version has seen its Lamport timestamp of the actor.
+func (r *Root) HasApplied(executedAt *time.Ticket) bool {
+	lamport, ok := r.version[executedAt.ActorIDHex()]
+	return ok && executedAt.Lamport() <= lamport
+}
+
+// MarkApplied marks that the operation executed at the given time has been
+// applied to this root.
+func (r *Root) MarkApplied(executedAt *time.Ticket) {
+	r.version.advance(executedAt)
+}
+
+// Version returns the version of this root, which advances whenever an
+// operation is applied. The root created from a snapshot starts with an empty
+// version.
+func (r *Root) Version() Version {
+	return r.version.DeepCopy()
+}
+
+// Policy returns the policy that limits the operations applied to this root.
+func (r *Root) Policy() Policy {
+	return r.policy
+}
+
+// SetPolicy sets the policy that limits the operations applied to this root.
+func (r *Root) SetPolicy(policy Policy) {
+	r.policy = policy
+}
+
+// TicketGenerator returns the generator that issues the tickets of the
+// local operations.
+func (r *Root) TicketGenerator() time.TicketGenerator {
+	if r.ticketGenerator == nil {
+		return time.LamportTicketGenerator{}
+	}
+	return r.ticketGenerator
+}
+
+// SetTicketGenerator sets the generator that issues the tickets of the local
+// operations.
+func (r *Root) SetTicketGenerator(generator time.TicketGenerator) {
+	r.ticketGenerator = generator
+}
+
 // DeepCopy copies itself deeply.
 func (r *Root) DeepCopy() *Root {
-	return NewRoot(r.object.DeepCopy().(*Object))
+	root := NewRoot(r.object.DeepCopy().(*Object))
+	root.version = r.version.DeepCopy()
+	root.policy = r.policy
+	root.ticketGenerator = r.ticketGenerator
+	return root
 }
 
-// GarbageCollect purge elements that were removed before the given time.
+// GarbageCollect purges elements that were removed before the given time.
+// The given time is usually the safe point computed by ComputeGCSafePoint.
+// It is the document-level entry point of GC: removed elements are purged
+// from their parents with all of their descendants, and tombstone nodes of
+// Text elements are purged. It returns the total count of purged elements
+// and nodes.
 func (r *Root) GarbageCollect(ticket *time.Ticket) int {
 	count := 0
 
@@ -117,11 +227,22 @@ func (r *Root) GarbageCollect(ticket *time.Ticket) int {
 	}
 
 	for _, text := range r.textElementWithGarbageMapByCreatedAt {
-		purgedTextNodes := text.purgeTextNodesWithGarbage(ticket)
-		if purgedTextNodes > 0 {
+		count += text.purgeTextNodesWithGarbage(ticket).Nodes
+
+		// NOTE: Tombstones removed after the given time remain, so the text
+		// is kept in the map until all of its tombstones are purged.
+		if text.removedNodesLen() == 0 {
 			delete(r.textElementWithGarbageMapByCreatedAt, text.CreatedAt().Key())
 		}
-		count += purgedTextNodes
+	}
+
+	// NOTE: Selections are not garbage of the document, so they are not
+	// counted.
+	for key, text := range r.textWithSelectionMapByCreatedAt {
+		text.EvictStaleSelections(ticket)
+		if len(text.selectionMap) == 0 {
+			delete(r.textWithSelectionMapByCreatedAt, key)
+		}
 	}
 
 	return count
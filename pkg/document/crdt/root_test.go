@@ -33,6 +33,22 @@ func registerTextElementWithGarbage(fromPos, toPos *crdt.RGATreeSplitNodePos, ro
 }
 
 func TestRoot(t *testing.T) {
+	t.Run("marshal test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		assert.Equal(t, "{}", root.Marshal())
+
+		obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		root.Object().Set("k2", obj)
+		root.RegisterElement(obj)
+		obj.Set("b", crdt.NewPrimitive(2, ctx.IssueTimeTicket()))
+		obj.Set("a", crdt.NewPrimitive(1, ctx.IssueTimeTicket()))
+		root.Object().Set("k1", crdt.NewPrimitive("v1", ctx.IssueTimeTicket()))
+
+		assert.Equal(t, `{"k1":"v1","k2":{"a":1,"b":2}}`, root.Marshal())
+		assert.Equal(t, root.Object().Marshal(), root.Marshal())
+	})
+
 	t.Run("garbage collection for array test", func(t *testing.T) {
 		root := helper.TestRoot()
 		ctx := helper.TextChangeContext(root)
@@ -190,4 +206,143 @@ func TestRoot(t *testing.T) {
 		assert.Equal(t, 1, root.GarbageCollect(time.MaxTicket))
 		assert.Equal(t, 0, root.GarbageLen())
 	})
+
+	t.Run("garbage collection for removed subtree test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		obj := root.Object()
+		child := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		arr := crdt.NewArray(crdt.NewRGATreeList(), ctx.IssueTimeTicket()).
+			Add(crdt.NewPrimitive(1, ctx.IssueTimeTicket()))
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(0, 1)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		child.Set("arr", arr)
+		child.Set("text", text)
+		obj.Set("child", child)
+
+		root = crdt.NewRoot(obj)
+		assert.Equal(t, 5, root.ElementMapLen())
+		assert.Equal(t, 1, root.GarbageLen())
+
+		removedAt := ctx.IssueTimeTicket()
+		deleted := obj.Delete("child", removedAt)
+		root.RegisterRemovedElementPair(obj, deleted)
+		assert.Equal(t, 5, root.GarbageLen())
+
+		assert.Equal(t, 0, root.GarbageCollect(time.InitialTicket))
+		assert.Equal(t, 4, root.GarbageCollect(removedAt))
+		assert.Equal(t, 0, root.GarbageLen())
+		assert.Equal(t, 1, root.ElementMapLen())
+		assert.Nil(t, root.FindByCreatedAt(child.CreatedAt()))
+		assert.Nil(t, root.FindByCreatedAt(text.CreatedAt()))
+		assert.Equal(t, `{}`, obj.Marshal())
+	})
+
+	t.Run("garbage collection for copied text test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello World", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(5, 11)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		root.Object().Set("text", text)
+
+		clone := root.DeepCopy()
+		assert.Equal(t, 1, clone.GarbageLen())
+		assert.Equal(t, 1, clone.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 0, clone.GarbageLen())
+		assert.Equal(t, `{"text":[{"val":"Hello"}]}`, clone.Object().Marshal())
+	})
+
+	t.Run("garbage collection for stale selections test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		// 01. the text that has selections when it is registered.
+		text1 := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		assert.NoError(t, text1.SelectByOffset(0, 0, ctx.IssueTimeTicket()))
+		root.Object().Set("text1", text1)
+		root.RegisterElement(text1)
+
+		// 02. the text that is selected after it is registered.
+		text2 := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		root.Object().Set("text2", text2)
+		root.RegisterElement(text2)
+		assert.NoError(t, text2.SelectByOffset(0, 0, ctx.IssueTimeTicket()))
+		root.RegisterTextWithSelection(text2)
+
+		// the selections are evicted without being counted as garbage.
+		assert.Equal(t, 0, root.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 0, text1.EvictStaleSelections(time.MaxTicket))
+		assert.Equal(t, 0, text2.EvictStaleSelections(time.MaxTicket))
+
+		// 03. the text selected again after its selections are evicted.
+		assert.NoError(t, text1.SelectByOffset(0, 0, ctx.IssueTimeTicket()))
+		root.RegisterTextWithSelection(text1)
+		assert.Equal(t, 0, root.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 0, text1.EvictStaleSelections(time.MaxTicket))
+	})
+
+	t.Run("gc safe point test", func(t *testing.T) {
+		actorA, _ := time.ActorIDFromHex("000000000000000000000001")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000002")
+		a, b := actorA.String(), actorB.String()
+		assert.Equal(t, time.MaxTicket, crdt.ComputeGCSafePoint(nil))
+
+		// 01. The lagging client that hasn't seen B holds back the safe point.
+		safePoint := crdt.ComputeGCSafePoint([]crdt.Version{{a: 5, b: 3}, {a: 2}})
+		assert.Equal(t, int64(0), safePoint.Lamport())
+
+		safePoint = crdt.ComputeGCSafePoint([]crdt.Version{{a: 5, b: 3}, {a: 5, b: 4}})
+		assert.Equal(t, int64(3), safePoint.Lamport())
+
+		// 02. Only the elements removed at or before the safe point are purged.
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		array := crdt.NewArray(crdt.NewRGATreeList(), ctx.IssueTimeTicket())
+		for i := 0; i < 3; i++ {
+			array.Add(crdt.NewPrimitive(i, ctx.IssueTimeTicket()))
+		}
+		for i, removedAt := range []*time.Ticket{time.NewTicket(3, 0, actorB), time.NewTicket(4, 0, actorA)} {
+			elem := array.Get(i)
+			array.DeleteByCreatedAt(elem.CreatedAt(), removedAt)
+			root.RegisterRemovedElementPair(array, elem)
+		}
+		assert.Equal(t, "[1]", array.Marshal())
+		assert.Equal(t, 2, root.GarbageLen())
+
+		assert.Equal(t, 1, root.GarbageCollect(safePoint))
+		assert.Equal(t, 1, root.GarbageLen())
+	})
+
+	t.Run("register elements test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		var elements []crdt.Element
+		for i := 0; i < 100; i++ {
+			elements = append(elements, crdt.NewPrimitive(i, ctx.IssueTimeTicket()))
+		}
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(0, 1)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		elements = append(elements, text)
+
+		// the elements registered in a batch are found like the ones
+		// registered one by one, including the text with garbage.
+		root.RegisterElements(elements)
+		for _, elem := range elements {
+			assert.Equal(t, elem, root.FindByCreatedAt(elem.CreatedAt()))
+		}
+		assert.Equal(t, root.Object(), root.FindByCreatedAt(root.Object().CreatedAt()))
+		assert.Equal(t, 1, root.GarbageLen())
+	})
 }
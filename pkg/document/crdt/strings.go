@@ -16,18 +16,52 @@
 
 package crdt
 
-import "bytes"
+import (
+	"bytes"
+	"unicode/utf8"
+)
 
 const hex = "0123456789abcdef"
 
+// EscapePolicy is the policy of escaping the strings in the JSON encoding.
+type EscapePolicy int
+
+const (
+	// EscapeJSON escapes only the characters that JSON requires. It is the
+	// default policy.
+	EscapeJSON EscapePolicy = iota
+
+	// EscapeHTMLSafe also escapes '<', '>' and '&' as \u003c, \u003e and
+	// \u0026, so that the JSON can be embedded in HTML safely.
+	EscapeHTMLSafe
+)
+
 // EscapeString returns a string that is safe to embed in a JSON document.
 func EscapeString(s string) string {
+	return EscapeStringWithPolicy(s, EscapeJSON)
+}
+
+// EscapeStringWithPolicy returns a string that is safe to embed in a JSON
+// document with the given escape policy. Invalid UTF-8 bytes are replaced
+// with \ufffd, as encoding/json does, since JSON text must be valid UTF-8.
+func EscapeStringWithPolicy(s string, policy EscapePolicy) string {
 	var buf bytes.Buffer
 
 	l := len(s)
 	for i := 0; i < l; i++ {
 		c := s[i]
-		if c >= 0x20 && c != '\\' && c != '"' {
+		if c >= utf8.RuneSelf {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError && size == 1 {
+				buf.WriteString(`\ufffd`)
+				continue
+			}
+			buf.WriteString(s[i : i+size])
+			i += size - 1
+			continue
+		}
+		isHTMLSpecial := c == '<' || c == '>' || c == '&'
+		if c >= 0x20 && c != '\\' && c != '"' && (policy != EscapeHTMLSafe || !isHTMLSpecial) {
 			buf.WriteByte(c)
 			continue
 		}
@@ -43,4 +43,21 @@ func TestEscapeString(t *testing.T) {
 		actual := EscapeString(str)
 		assert.Equal(t, expected, actual)
 	})
+
+	t.Run("escape string with html safe policy", func(t *testing.T) {
+		str := `<b>"a" & b</b>`
+		assert.Equal(t, `<b>\"a\" & b</b>`, EscapeStringWithPolicy(str, EscapeJSON))
+		assert.Equal(
+			t,
+			`\u003cb\u003e\"a\" \u0026 b\u003c/b\u003e`,
+			EscapeStringWithPolicy(str, EscapeHTMLSafe),
+		)
+	})
+
+	t.Run("escape string with invalid UTF-8", func(t *testing.T) {
+		str := "a\xffb\xed\xa0\x80c\xc3"
+		expected := `a\ufffdb\ufffd\ufffd\ufffdc\ufffd`
+		actual := EscapeString(str)
+		assert.Equal(t, expected, actual)
+	})
 }
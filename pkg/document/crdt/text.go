@@ -17,18 +17,86 @@
 package crdt
 
 import (
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"sort"
 	"strings"
 	"unicode/utf16"
+	"unicode/utf8"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// embedString is the string representation of an embedded inline object.
+// It is the object replacement character, which is a single UTF-16 code unit.
+const embedString = "\uFFFC"
+
+var (
+	// ErrOutOfRange is returned when the given offset or line is out of the
+	// range of the text.
+	ErrOutOfRange = errors.New("out of range")
+
+	// ErrNodeLimitExceeded is returned when the number of nodes exceeds the
+	// given limit.
+	ErrNodeLimitExceeded = errors.New("node limit exceeded")
+
+	// ErrInvalidRange is returned when the end of the given range is before
+	// the start of it.
+	ErrInvalidRange = errors.New("invalid range")
+
+	// ErrDifferentText is returned when merging the texts that are not
+	// forked from the same text.
+	ErrDifferentText = errors.New("different text")
+
+	// ErrRemovedNode is returned when the node of the given position has
+	// been removed.
+	ErrRemovedNode = errors.New("removed node")
+)
+
+// TreeStats is the statistics of the index tree of Text.
+type TreeStats struct {
+	// Height is the height of the tree.
+	Height int
+
+	// NodeCount is the number of the nodes in the tree including the
+	// tombstones and the initial head.
+	NodeCount int
+}
+
+// Block is a block of Text separated by the block separator('\n'). From and
+// To are the integer offsets of the content of the block without the
+// separator, and Attributes are the block-level attributes.
+type Block struct {
+	From       int
+	To         int
+	Attributes map[string]string
+}
+
+// PurgeResult is the result of purging the removed nodes of Text. It is
+// used to estimate how much storage has been reclaimed.
+type PurgeResult struct {
+	// Nodes is the number of the purged nodes.
+	Nodes int
+
+	// CodeUnits is the total length of the purged nodes in UTF-16 code units.
+	CodeUnits int
+
+	// AttrEntries is the total number of the attribute entries of the purged
+	// nodes, including the removed ones.
+	AttrEntries int
+}
+
 // TextValue is a value of Text which has an attributes that represent
 // the text style.
 type TextValue struct {
 	value string
 	attrs *RHT
+
+	// embed is the payload of an embedded inline object such as an image or
+	// a mention. If it is not nil, this value is an indivisible node whose
+	// length is 1 and `value` is not used.
+	embed map[string]string
 }
 
 // NewTextValue creates a value of Text.
@@ -39,6 +107,30 @@ func NewTextValue(value string, attrs *RHT) *TextValue {
 	}
 }
 
+// NewEmbedTextValue creates a value of Text that holds an embedded inline
+// object instead of a string.
+func NewEmbedTextValue(embed map[string]string, attrs *RHT) *TextValue {
+	payload := make(map[string]string, len(embed))
+	for key, value := range embed {
+		payload[key] = value
+	}
+
+	return &TextValue{
+		attrs: attrs,
+		embed: payload,
+	}
+}
+
+// IsEmbed returns whether this value is an embedded inline object or not.
+func (t *TextValue) IsEmbed() bool {
+	return t.embed != nil
+}
+
+// Embed returns the payload of the embedded inline object of this value.
+func (t *TextValue) Embed() map[string]string {
+	return t.embed
+}
+
 // Attrs returns the attributes of this value.
 func (t *TextValue) Attrs() *RHT {
 	return t.attrs
@@ -50,33 +142,100 @@ func (t *TextValue) Value() string {
 }
 
 // Len returns the length of this value.
-// It is calculated in UTF-16 code units.
+// It is calculated in UTF-16 code units. The length of an embedded inline
+// object is always 1.
 func (t *TextValue) Len() int {
+	if t.embed != nil {
+		return 1
+	}
+
 	encoded := utf16.Encode([]rune(t.value))
 	return len(encoded)
 }
 
 // String returns the string representation of this value.
 func (t *TextValue) String() string {
+	if t.embed != nil {
+		return embedString
+	}
+
 	return t.value
 }
 
 // Marshal returns the JSON encoding of this text.
 func (t *TextValue) Marshal() string {
-	if len(t.attrs.Elements()) == 0 {
-		return fmt.Sprintf(`{"val":"%s"}`, EscapeString(t.value))
+	return t.MarshalWithPolicy(EscapeJSON)
+}
+
+// MarshalWithPolicy returns the JSON encoding of this text with the given
+// escape policy.
+func (t *TextValue) MarshalWithPolicy(policy EscapePolicy) string {
+	return t.marshal(policy, nil)
+}
+
+// MarshalWithDefaults returns the JSON encoding of this text without the
+// attributes whose values are the same as the given default ones, such as
+// "bold":"false", to reduce the size of the encoding.
+func (t *TextValue) MarshalWithDefaults(defaults map[string]string) string {
+	return t.marshal(EscapeJSON, defaults)
+}
+
+// marshal returns the JSON encoding of this text with the given escape
+// policy, omitting the attributes of the given default values.
+func (t *TextValue) marshal(policy EscapePolicy, defaults map[string]string) string {
+	if t.embed != nil {
+		return t.marshalEmbed(policy, defaults)
+	}
+
+	attrs := t.attrs.marshal(policy, defaults)
+	if attrs == "{}" {
+		return fmt.Sprintf(`{"val":"%s"}`, EscapeStringWithPolicy(t.value, policy))
 	}
 
 	return fmt.Sprintf(
 		`{"attrs":%s,"val":"%s"}`,
-		t.attrs.Marshal(),
-		EscapeString(t.value),
+		attrs,
+		EscapeStringWithPolicy(t.value, policy),
 	)
 }
 
+// marshalEmbed returns the JSON encoding of the embedded inline object.
+func (t *TextValue) marshalEmbed(policy EscapePolicy, defaults map[string]string) string {
+	keys := make([]string, 0, len(t.embed))
+	for k := range t.embed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	sb := strings.Builder{}
+	sb.WriteString("{")
+	for idx, k := range keys {
+		if idx > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(fmt.Sprintf(
+			`"%s":"%s"`,
+			EscapeStringWithPolicy(k, policy),
+			EscapeStringWithPolicy(t.embed[k], policy),
+		))
+	}
+	sb.WriteString("}")
+
+	attrs := t.attrs.marshal(policy, defaults)
+	if attrs == "{}" {
+		return fmt.Sprintf(`{"embed":%s}`, sb.String())
+	}
+
+	return fmt.Sprintf(`{"attrs":%s,"embed":%s}`, attrs, sb.String())
+}
+
 // structureAsString returns a String containing the metadata of this value
 // for debugging purpose.
 func (t *TextValue) structureAsString() string {
+	if t.embed != nil {
+		return fmt.Sprintf(`%s %s`, t.attrs.Marshal(), t.marshalEmbed(EscapeJSON, nil))
+	}
+
 	return fmt.Sprintf(
 		`%s "%s"`,
 		t.attrs.Marshal(),
@@ -84,8 +243,13 @@ func (t *TextValue) structureAsString() string {
 	)
 }
 
-// Split splits this value by the given offset.
+// Split splits this value by the given offset. An embedded inline object
+// can't be split because it is indivisible.
 func (t *TextValue) Split(offset int) RGATreeSplitValue {
+	if t.embed != nil {
+		panic("embedded inline object cannot be split")
+	}
+
 	value := t.value
 	encoded := utf16.Encode([]rune(value))
 	t.value = string(utf16.Decode(encoded[0:offset]))
@@ -98,6 +262,10 @@ func (t *TextValue) Split(offset int) RGATreeSplitValue {
 
 // DeepCopy copies itself deeply.
 func (t *TextValue) DeepCopy() RGATreeSplitValue {
+	if t.embed != nil {
+		return NewEmbedTextValue(t.embed, t.attrs.DeepCopy())
+	}
+
 	return &TextValue{
 		attrs: t.attrs.DeepCopy(),
 		value: t.value,
@@ -120,6 +288,20 @@ type Text struct {
 	createdAt    *time.Ticket
 	movedAt      *time.Ticket
 	removedAt    *time.Ticket
+
+	// onChange is called with the change of each edit if it is registered.
+	onChange func(change TextChange)
+
+	// watchers are the consumers of the changes registered by Watch.
+	watchers []*textWatcher
+
+	// tail is the cache of the last node updated by Append, so that appends
+	// don't have to find the end position through the index tree. It is
+	// stale when a node has been inserted after it or it has been removed.
+	tail *RGATreeSplitNode[*TextValue]
+
+	// typing is the typing session begun by BeginTyping.
+	typing *typingSession
 }
 
 // NewText creates a new instance of Text.
@@ -131,15 +313,31 @@ func NewText(elements *RGATreeSplit[*TextValue], createdAt *time.Ticket) *Text {
 	}
 }
 
-// String returns the string representation of this Text.
+// String returns the string representation of this Text. Nodes that have
+// a removal time are treated as removed regardless of whether they have been
+// purged by garbage collection or not.
 func (t *Text) String() string {
 	var values []string
+	for _, node := range t.VisibleNodes() {
+		values = append(values, node.String())
+	}
+
+	return strings.Join(values, "")
+}
+
+// StringAsOf returns the string representation of this Text as it existed at
+// the given logical time. Nodes created after the given ticket are excluded,
+// and nodes removed after the given ticket are treated as still present.
+// Nodes that have already been purged by garbage collection can't be restored.
+func (t *Text) StringAsOf(ticket *time.Ticket) string {
+	var values []string
 
 	node := t.rgaTreeSplit.initialHead.next
 	for node != nil {
 		if node.createdAt().Compare(t.createdAt) == 0 {
 			// last line
-		} else if node.removedAt == nil {
+		} else if !node.createdAt().After(ticket) &&
+			(node.removedAt == nil || node.removedAt.After(ticket)) {
 			values = append(values, node.String())
 		}
 		node = node.next
@@ -148,8 +346,73 @@ func (t *Text) String() string {
 	return strings.Join(values, "")
 }
 
+// SnapshotAsOf returns a new Text of this Text as it existed at the given
+// logical time, filtering the nodes like StringAsOf. The nodes keep their
+// IDs, so the positions in the past state can be resolved on the snapshot.
+// The attributes updated after the given ticket are dropped, because the
+// values they have overwritten are not kept.
+func (t *Text) SnapshotAsOf(ticket *time.Ticket) *Text {
+	rgaTreeSplit := NewRGATreeSplit(InitialTextNode())
+
+	current := rgaTreeSplit.InitialHead()
+	for node := t.rgaTreeSplit.initialHead.next; node != nil; node = node.next {
+		if node.createdAt().Compare(t.createdAt) == 0 || node.createdAt().After(ticket) ||
+			(node.removedAt != nil && !node.removedAt.After(ticket)) {
+			continue
+		}
+
+		value := NewTextValue(node.value.value, node.value.attrs.copyAsOf(ticket))
+		if node.value.IsEmbed() {
+			value = NewEmbedTextValue(node.value.embed, node.value.attrs.copyAsOf(ticket))
+		}
+		current = rgaTreeSplit.InsertAfter(current, NewRGATreeSplitNode(node.id, value))
+
+		// NOTE: The previous node at the insertion is linked only if it is
+		// in the snapshot, because it may have been created after the ticket.
+		if insPrevID := node.InsPrevID(); insPrevID != nil {
+			if insPrev := rgaTreeSplit.FindNode(insPrevID); insPrev != nil && insPrev.id.Equal(insPrevID) {
+				current.SetInsPrev(insPrev)
+			}
+		}
+	}
+
+	return NewText(rgaTreeSplit, t.createdAt)
+}
+
 // Marshal returns the JSON encoding of this Text.
 func (t *Text) Marshal() string {
+	return t.MarshalWithPolicy(EscapeJSON)
+}
+
+// MarshalWithPolicy returns the JSON encoding of this text with the given
+// escape policy, e.g. EscapeHTMLSafe to embed it in HTML.
+func (t *Text) MarshalWithPolicy(policy EscapePolicy) string {
+	var values []string
+	for _, node := range t.VisibleNodes() {
+		values = append(values, node.value.MarshalWithPolicy(policy))
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(values, ","))
+}
+
+// MarshalWithDefaults returns the JSON encoding of this Text without the
+// attributes whose values are the same as the given default ones, so that
+// the text carrying the explicit defaults is encoded like the unstyled one.
+func (t *Text) MarshalWithDefaults(defaults map[string]string) string {
+	var values []string
+	for _, node := range t.VisibleNodes() {
+		values = append(values, node.value.MarshalWithDefaults(defaults))
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(values, ","))
+}
+
+// MarshalWithLimit returns the JSON encoding of this Text with at most the
+// given number of nodes. If the text has more nodes than the limit, it
+// returns the encoding of the nodes within the limit with the truncated flag
+// and ErrNodeLimitExceeded, so the caller can decide whether to serve the
+// partial content or reject it.
+func (t *Text) MarshalWithLimit(maxNodes int) (string, bool, error) {
 	var values []string
 
 	node := t.rgaTreeSplit.initialHead.next
@@ -157,12 +420,59 @@ func (t *Text) Marshal() string {
 		if node.createdAt().Compare(t.createdAt) == 0 {
 			// last line
 		} else if node.removedAt == nil {
+			if len(values) >= maxNodes {
+				return fmt.Sprintf("[%s]", strings.Join(values, ",")), true, fmt.Errorf(
+					"more than %d nodes: %w", maxNodes, ErrNodeLimitExceeded,
+				)
+			}
 			values = append(values, node.Marshal())
 		}
 		node = node.next
 	}
 
-	return fmt.Sprintf("[%s]", strings.Join(values, ","))
+	return fmt.Sprintf("[%s]", strings.Join(values, ",")), false, nil
+}
+
+// MarshalChunk returns the JSON encoding of the live nodes after the given
+// node as an array of at most the given bytes, to transmit a large Text in
+// chunks. A nil cursor(`after`) starts from the beginning, and the returned
+// cursor is the ID of the last node in the chunk, which is passed to get the
+// next chunk until done is true. The elements of the chunks concatenated in
+// order are the elements of Marshal. A chunk has at least one node even if
+// the node alone exceeds the given bytes.
+func (t *Text) MarshalChunk(
+	after *RGATreeSplitNodeID,
+	maxBytes int,
+) (string, *RGATreeSplitNodeID, bool, error) {
+	node := t.rgaTreeSplit.initialHead
+	if after != nil {
+		node = t.rgaTreeSplit.FindNode(after)
+		if node == nil || !node.id.Equal(after) {
+			return "", nil, false, fmt.Errorf("%s: %w", after.StructureAsString(), ErrNodeNotFound)
+		}
+	}
+
+	var values []string
+	size := len("[]")
+	next := after
+	for node = node.next; node != nil; node = node.next {
+		if node.createdAt().Compare(t.createdAt) == 0 || node.removedAt != nil {
+			continue
+		}
+
+		value := node.Marshal()
+		if len(values) > 0 && size+len(",")+len(value) > maxBytes {
+			return fmt.Sprintf("[%s]", strings.Join(values, ",")), next, false, nil
+		}
+		if len(values) > 0 {
+			size += len(",")
+		}
+		size += len(value)
+		values = append(values, value)
+		next = node.id
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(values, ",")), next, true, nil
 }
 
 // DeepCopy copies itself deeply.
@@ -175,16 +485,91 @@ func (t *Text) DeepCopy() Element {
 		insPrevID := node.InsPrevID()
 		if insPrevID != nil {
 			insPrevNode := rgaTreeSplit.FindNode(insPrevID)
-			if insPrevNode == nil {
-				panic("insPrevNode should be presence")
+			if checkInvariant(insPrevNode != nil, "find insPrev %s", insPrevID.StructureAsString()) == nil {
+				current.SetInsPrev(insPrevNode)
 			}
-			current.SetInsPrev(insPrevNode)
 		}
 	}
 
 	return NewText(rgaTreeSplit, t.createdAt)
 }
 
+// Flatten returns a new Text that has only the visible content of this Text.
+// The tombstones are dropped, and the adjacent contents with the same
+// attributes are merged into a single node, so the result is a canonical
+// form for exporting or hashing. Unlike DeepCopy, the IDs of the nodes and
+// the selections are not preserved.
+func (t *Text) Flatten() *Text {
+	var values []*TextValue
+	node := t.rgaTreeSplit.initialHead.next
+	for node != nil {
+		if node.removedAt == nil && node.contentLen() > 0 {
+			val := node.value
+			last := len(values) - 1
+			if last >= 0 && !val.IsEmbed() && !values[last].IsEmbed() &&
+				values[last].attrs.Marshal() == val.attrs.Marshal() {
+				values[last].value += val.value
+			} else if val.IsEmbed() {
+				values = append(values, NewEmbedTextValue(val.embed, val.attrs.liveCopy()))
+			} else {
+				values = append(values, NewTextValue(val.value, val.attrs.liveCopy()))
+			}
+		}
+		node = node.next
+	}
+
+	flattened := NewText(NewRGATreeSplit(InitialTextNode()), t.createdAt)
+	prev := flattened.rgaTreeSplit.initialHead
+	for i, val := range values {
+		// NOTE: The nodes are created after this Text with the distinct
+		// delimiters so that they are not mistaken for this Text itself.
+		createdAt := time.NewTicket(
+			t.createdAt.Lamport(),
+			t.createdAt.Delimiter()+uint32(i)+1,
+			t.createdAt.ActorID(),
+		)
+		prev = flattened.rgaTreeSplit.InsertAfter(
+			prev,
+			NewRGATreeSplitNode(NewRGATreeSplitNodeID(createdAt, 0), val),
+		)
+	}
+
+	return flattened
+}
+
+// ContentHash returns a hash of the visible content and the attributes of
+// this Text. The Texts with the same visible content and attributes have the
+// same hash regardless of how their nodes are split, so it can be used to
+// check whether the Texts are probably equal without comparing the contents.
+func (t *Text) ContentHash() uint64 {
+	hash := fnv.New64a()
+
+	prevAttrs := ""
+	node := t.rgaTreeSplit.initialHead.next
+	for node != nil {
+		if node.removedAt == nil && node.contentLen() > 0 {
+			// NOTE: The attributes are written only at the boundaries of the
+			// runs, so the hash doesn't depend on the splits of the nodes.
+			attrs := node.value.attrs.Marshal()
+			if attrs != prevAttrs || node.value.IsEmbed() {
+				_, _ = hash.Write([]byte{0})
+				_, _ = hash.Write([]byte(attrs))
+				_, _ = hash.Write([]byte{0})
+				prevAttrs = attrs
+			}
+
+			if node.value.IsEmbed() {
+				_, _ = hash.Write([]byte(node.value.marshalEmbed(EscapeJSON, nil)))
+			} else {
+				_, _ = hash.Write([]byte(node.value.value))
+			}
+		}
+		node = node.next
+	}
+
+	return hash.Sum64()
+}
+
 // CreatedAt returns the creation time of this Text.
 func (t *Text) CreatedAt() *time.Ticket {
 	return t.createdAt
@@ -220,12 +605,153 @@ func (t *Text) Remove(removedAt *time.Ticket) bool {
 	return false
 }
 
+// Len returns the length of this Text in UTF-16 code units. It takes O(1)
+// because the root of the index tree keeps the total length of the live
+// nodes as its weight, which is updated incrementally by edits and GC.
+func (t *Text) Len() int {
+	return t.rgaTreeSplit.length()
+}
+
+// IsEmpty returns whether this Text has no live content. The removed nodes
+// have no weight in the index tree, so it is checked by the weight of the
+// root in O(1) without walking the nodes.
+func (t *Text) IsEmpty() bool {
+	return t.rgaTreeSplit.length() == 0
+}
+
 // CreateRange returns a pair of RGATreeSplitNodePos of the given integer offsets.
 func (t *Text) CreateRange(from, to int) (*RGATreeSplitNodePos, *RGATreeSplitNodePos) {
 	return t.rgaTreeSplit.createRange(from, to)
 }
 
+// CreateRangeChecked returns a pair of RGATreeSplitNodePos of the given
+// integer offsets like CreateRange, but it returns an error before touching
+// the tree if the offsets are negative, reversed or beyond the length of this
+// Text.
+func (t *Text) CreateRangeChecked(from, to int) (*RGATreeSplitNodePos, *RGATreeSplitNodePos, error) {
+	if to < from {
+		return nil, nil, fmt.Errorf("range %d..%d: %w", from, to, ErrInvalidRange)
+	}
+	if from < 0 || to > t.Len() {
+		return nil, nil, fmt.Errorf("range %d..%d of length %d: %w", from, to, t.Len(), ErrOutOfRange)
+	}
+
+	fromPos, toPos := t.CreateRange(from, to)
+	return fromPos, toPos, nil
+}
+
+// RuneLen returns the length of this Text in Unicode code points, while Len
+// returns it in UTF-16 code units.
+func (t *Text) RuneLen() int {
+	length := 0
+	node := t.rgaTreeSplit.initialHead.next
+	for node != nil {
+		if node.removedAt == nil {
+			length += utf8.RuneCountInString(node.String())
+		}
+		node = node.next
+	}
+
+	return length
+}
+
+// CreateRangeByRune returns a pair of RGATreeSplitNodePos of the given
+// offsets in Unicode code points, for the clients that index the text by
+// code points instead of UTF-16 code units.
+func (t *Text) CreateRangeByRune(from, to int) (*RGATreeSplitNodePos, *RGATreeSplitNodePos) {
+	return t.CreateRange(t.offsetOfRune(from), t.offsetOfRune(to))
+}
+
+// offsetOfRune converts the given offset in Unicode code points into the
+// offset in UTF-16 code units by walking the live nodes.
+func (t *Text) offsetOfRune(runeOffset int) int {
+	offset := 0
+	node := t.rgaTreeSplit.initialHead.next
+	for node != nil && runeOffset > 0 {
+		if node.removedAt == nil {
+			for _, r := range node.String() {
+				if runeOffset == 0 {
+					break
+				}
+				offset += len(utf16.Encode([]rune{r}))
+				runeOffset--
+			}
+		}
+		node = node.next
+	}
+
+	return offset + runeOffset
+}
+
+// OffsetOfNode returns the integer offset of the given node ID. It uses
+// the weights of the index tree instead of walking the nodes. If the node
+// has been removed, the offset where the node was placed is returned.
+func (t *Text) OffsetOfNode(id *RGATreeSplitNodeID) (int, error) {
+	return t.rgaTreeSplit.indexOf(id)
+}
+
+// CreatePos returns the position of the given relative offset from the node
+// of the given ID, such as the one of a persisted anchor. Unlike
+// NewRGATreeSplitNodePos, it returns an error if the node is not found or the
+// offset is beyond the content of the node, including the nodes split from
+// it.
+func (t *Text) CreatePos(id *RGATreeSplitNodeID, relativeOffset int) (*RGATreeSplitNodePos, error) {
+	if node := t.rgaTreeSplit.findFloorNode(id); node == nil || !node.id.Equal(id) {
+		return nil, fmt.Errorf("%s: %w", id.StructureAsString(), ErrNodeNotFound)
+	}
+	if relativeOffset < 0 {
+		return nil, fmt.Errorf("relative offset %d: %w", relativeOffset, ErrOutOfRange)
+	}
+
+	pos := NewRGATreeSplitNodePos(id, relativeOffset)
+	if _, err := t.rgaTreeSplit.posIndexOf(pos); err != nil {
+		return nil, fmt.Errorf("relative offset %d of %s: %w", relativeOffset, id.StructureAsString(), ErrOutOfRange)
+	}
+
+	return pos, nil
+}
+
+// NodeExists returns whether the node of the given ID is in this Text and
+// whether it has been removed. A node purged by GC doesn't exist, so the
+// persisted positions of it should fall back to ResolvePos.
+func (t *Text) NodeExists(id *RGATreeSplitNodeID) (exists, removed bool) {
+	node := t.rgaTreeSplit.FindNode(id)
+	if node == nil || !node.id.Equal(id) {
+		return false, false
+	}
+
+	return true, node.removedAt != nil
+}
+
+// ResolvePos returns the integer offset of the given position, such as the
+// persisted cursor decoded by ParseRGATreeSplitNodePos. The position stays
+// valid after the node has been split, and if the node has been removed or
+// purged by GC, it snaps to the nearest live position.
+func (t *Text) ResolvePos(pos *RGATreeSplitNodePos) int {
+	return t.rgaTreeSplit.resolve(pos)
+}
+
+// AuthorAt returns the actor who inserted the character at the given
+// offset. The offset must be less than the length of this Text.
+func (t *Text) AuthorAt(offset int) (*time.ActorID, error) {
+	if offset < 0 || offset >= t.Len() {
+		return nil, fmt.Errorf("author at %d: %w", offset, ErrOutOfRange)
+	}
+
+	// NOTE: The position after the character is found, because the index
+	// tree prefers the left node at the boundary of the nodes.
+	pos := t.rgaTreeSplit.findNodePos(offset + 1)
+	return pos.id.createdAt.ActorID(), nil
+}
+
 // Edit edits the given range with the given content and attributes.
+//
+// latestCreatedAtMapByActor is the latest creation time of the nodes by
+// actor that the editor has seen, and only the nodes created before it are
+// removed. A nil map means that the editor has seen all the nodes, as in a
+// local edit or the first edit from an actor, so it is safe to pass nil.
+// The returned map is never nil. It returns an error wrapping
+// ErrInvariantViolation if a position is beyond the content of its node.
 func (t *Text) Edit(
 	from,
 	to *RGATreeSplitNodePos,
@@ -233,78 +759,1060 @@ func (t *Text) Edit(
 	content string,
 	attributes map[string]string,
 	executedAt *time.Ticket,
-) (*RGATreeSplitNodePos, map[string]*time.Ticket) {
+) (*RGATreeSplitNodePos, map[string]*time.Ticket, error) {
 	val := NewTextValue(content, NewRHT())
 	for key, value := range attributes {
 		val.attrs.Set(key, value, executedAt)
 	}
 
-	cursorPos, latestCreatedAtMapByActor := t.rgaTreeSplit.edit(
+	fromIdx, toIdx := t.offsetsOf(from, to)
+	cursorPos, latestCreatedAtMapByActor, err := t.rgaTreeSplit.edit(
 		from,
 		to,
 		latestCreatedAtMapByActor,
 		val,
 		executedAt,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
+	t.notifyEdit(fromIdx, toIdx, content, attributes)
+	t.trackTyping(from, to, content, executedAt, cursorPos)
 
-	return cursorPos, latestCreatedAtMapByActor
+	return cursorPos, latestCreatedAtMapByActor, nil
 }
 
-// Style applies the given attributes of the given range.
-func (t *Text) Style(
+// InsertAt inserts the given content with the given attributes at the given
+// integer offset. It is a shorthand of CreateRange and Edit for authoring
+// documents without an editor.
+func (t *Text) InsertAt(
+	offset int,
+	content string,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) error {
+	from, to, err := t.CreateRangeChecked(offset, offset)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = t.Edit(from, to, nil, content, attributes, executedAt)
+	return err
+}
+
+// EditByOffset edits the given range of integer offsets with the given
+// content and attributes. A negative offset counts from the end of this Text
+// like Python, so -1 is the offset of the last code unit. It returns an error
+// if the offsets are out of the range even after counting from the end, or
+// if the content exceeds the limit of the given policy.
+func (t *Text) EditByOffset(
 	from,
-	to *RGATreeSplitNodePos,
+	to int,
+	content string,
 	attributes map[string]string,
+	policy Policy,
 	executedAt *time.Ticket,
-) {
-	// 01. Split nodes with from and to
-	_, toRight := t.rgaTreeSplit.findNodeWithSplit(to, executedAt)
-	_, fromRight := t.rgaTreeSplit.findNodeWithSplit(from, executedAt)
+) error {
+	if err := policy.CheckEditContent(content); err != nil {
+		return err
+	}
 
-	// 02. style nodes between from and to
-	nodes := t.rgaTreeSplit.findBetween(fromRight, toRight)
-	for _, node := range nodes {
-		val := node.value
-		for key, value := range attributes {
-			val.attrs.Set(key, value, executedAt)
+	from, to, err := t.relativeRange(from, to)
+	if err != nil {
+		return err
+	}
+
+	fromPos, toPos, err := t.CreateRangeChecked(from, to)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = t.Edit(fromPos, toPos, nil, content, attributes, executedAt)
+	return err
+}
+
+// Append inserts the given content with the given attributes at the end of
+// this Text. The end position is taken from the cached tail node if it is
+// still the last node, so appending to a log-like Text doesn't walk the
+// index tree. It returns an error like InsertAt.
+func (t *Text) Append(content string, attributes map[string]string, executedAt *time.Ticket) error {
+	var pos *RGATreeSplitNodePos
+	if t.tail != nil && t.tail.next == nil && t.tail.prev != nil && t.tail.removedAt == nil {
+		pos = NewRGATreeSplitNodePos(t.tail.id, t.tail.contentLen())
+	} else {
+		var err error
+		if pos, _, err = t.CreateRangeChecked(t.Len(), t.Len()); err != nil {
+			return err
 		}
 	}
+	if _, _, err := t.Edit(pos, pos, nil, content, attributes, executedAt); err != nil {
+		return err
+	}
+
+	t.tail = nil
+	if last := t.rgaTreeSplit.lastNode(); last != t.rgaTreeSplit.initialHead && last.removedAt == nil {
+		t.tail = last
+	}
+
+	return nil
 }
 
-// Select stores that the given range has been selected.
-func (t *Text) Select(
-	from *RGATreeSplitNodePos,
+// Replace replaces the given range with the given content. Like typing over
+// a selection in an editor, the content inherits the attributes of the
+// character immediately before the range, or of the first character of the
+// range if the range starts at the beginning of this Text. The lock of a
+// locked range is not inherited. It returns an error like Edit.
+func (t *Text) Replace(
+	from,
 	to *RGATreeSplitNodePos,
+	content string,
 	executedAt *time.Ticket,
-) {
-	if prev, ok := t.selectionMap[executedAt.ActorIDHex()]; !ok || executedAt.After(prev.updatedAt) {
-		t.selectionMap[executedAt.ActorIDHex()] = newSelection(from, to, executedAt)
-	}
+) (*RGATreeSplitNodePos, error) {
+	attributes := t.attributesAround(from)
+	cursorPos, _, err := t.Edit(from, to, nil, content, attributes, executedAt)
+	return cursorPos, err
 }
 
-// Nodes returns the internal nodes of this Text.
-func (t *Text) Nodes() []*RGATreeSplitNode[*TextValue] {
-	return t.rgaTreeSplit.nodes()
+// attributesAround returns the attributes of the character immediately
+// before the given position, or after it if the position is at the
+// beginning of this Text.
+func (t *Text) attributesAround(pos *RGATreeSplitNodePos) map[string]string {
+	idx, err := t.rgaTreeSplit.indexOf(pos.getAbsoluteID())
+	if err != nil || t.Len() == 0 {
+		return nil
+	}
+
+	// NOTE: The index tree prefers the left node at the boundary of the
+	// nodes, so the node found by idx contains the character before it.
+	if idx == 0 {
+		idx = 1
+	}
+	splayNode, _ := t.rgaTreeSplit.treeByIndex.Find(idx)
+	return withoutLock(splayNode.Value().value.attrs.Elements())
 }
 
-// StructureAsString returns a String containing the metadata of the text
-// for debugging purpose.
-func (t *Text) StructureAsString() string {
-	return t.rgaTreeSplit.StructureAsString()
+// InheritedAttributes returns the attributes that the content inserted into
+// the given range of integer offsets inherits under the given policy: the
+// ones of the character before the range for InheritLeft, or of the
+// character after the range for InheritRight. It returns nil if there is no
+// such character. The lock of a locked range is not inherited.
+func (t *Text) InheritedAttributes(from, to int, inheritance AttributeInheritance) map[string]string {
+	switch inheritance {
+	case InheritLeft:
+		return withoutLock(t.attributesOfChar(from - 1))
+	case InheritRight:
+		return withoutLock(t.attributesOfChar(to))
+	default:
+		return nil
+	}
 }
 
-// CheckWeight returns false when there is an incorrect weight node.
-// for debugging purpose.
-func (t *Text) CheckWeight() bool {
-	return t.rgaTreeSplit.CheckWeight()
+// attributesOfChar returns the attributes of the character at the given
+// integer offset, or nil if the offset is out of the range.
+func (t *Text) attributesOfChar(offset int) map[string]string {
+	if offset < 0 || offset >= t.Len() {
+		return nil
+	}
+
+	// NOTE: The index tree prefers the left node at the boundary of the
+	// nodes, so the node found by the offset after the character contains it.
+	splayNode, _ := t.rgaTreeSplit.treeByIndex.Find(offset + 1)
+	return splayNode.Value().value.attrs.Elements()
 }
 
-// removedNodesLen returns length of removed nodes
-func (t *Text) removedNodesLen() int {
-	return t.rgaTreeSplit.removedNodesLen()
+// EditEmbed edits the given range with the given embedded inline object and
+// attributes like Edit.
+func (t *Text) EditEmbed(
+	from,
+	to *RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	embed map[string]string,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) (*RGATreeSplitNodePos, map[string]*time.Ticket, error) {
+	val := NewEmbedTextValue(embed, NewRHT())
+	for key, value := range attributes {
+		val.attrs.Set(key, value, executedAt)
+	}
+
+	fromIdx, toIdx := t.offsetsOf(from, to)
+	cursorPos, latestCreatedAtMapByActor, err := t.rgaTreeSplit.edit(
+		from,
+		to,
+		latestCreatedAtMapByActor,
+		val,
+		executedAt,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	t.notifyEdit(fromIdx, toIdx, embedString, attributes)
+	t.abandonTyping()
+
+	return cursorPos, latestCreatedAtMapByActor, nil
 }
 
-// purgeTextNodesWithGarbage physically purges nodes that have been removed.
-func (t *Text) purgeTextNodesWithGarbage(ticket *time.Ticket) int {
-	return t.rgaTreeSplit.purgeTextNodesWithGarbage(ticket)
+// Style applies the given attributes of the given range. Like Edit, the
+// nodes of the range that the editor hasn't seen, the ones inserted
+// concurrently by other actors, are kept as they are, so the replicas converge
+// regardless of the order the Style and the concurrent Edit are applied. The
+// latest creation times by actor of the styled nodes are returned to be
+// recorded in the operation. It returns an error without touching the nodes
+// if the to position is before the from position, or if either of them
+// doesn't resolve to a node, for example because the node has been purged by
+// GC. The attributes are applied atomically: if any of them is invalid, an
+// error naming the key is returned and none of them is applied to any node.
+func (t *Text) Style(
+	from,
+	to *RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) (map[string]*time.Ticket, error) {
+	fromIdx, err := t.rgaTreeSplit.posIndexOf(from)
+	if err != nil {
+		return nil, fmt.Errorf("style from: %w", err)
+	}
+	toIdx, err := t.rgaTreeSplit.posIndexOf(to)
+	if err != nil {
+		return nil, fmt.Errorf("style to: %w", err)
+	}
+	if toIdx < fromIdx {
+		return nil, fmt.Errorf("style range %d..%d: %w", fromIdx, toIdx, ErrInvalidRange)
+	}
+	if err := validateAttrs(attributes); err != nil {
+		return nil, fmt.Errorf("style: %w", err)
+	}
+
+	return t.style(fromIdx, from, to, latestCreatedAtMapByActor, attributes, executedAt)
+}
+
+// ApplyStyle applies the given attributes of the given range like Style, but
+// it is used to execute the Style operations of the other replicas. Unlike
+// Style, a range that doesn't resolve on this replica, for example because
+// the node of a position has been purged by GC, is not an error: nothing is
+// styled, so the rest of the change can still be applied.
+func (t *Text) ApplyStyle(
+	from,
+	to *RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) error {
+	fromIdx, err := t.rgaTreeSplit.posIndexOf(from)
+	if err != nil {
+		return nil
+	}
+	toIdx, err := t.rgaTreeSplit.posIndexOf(to)
+	if err != nil || toIdx < fromIdx {
+		return nil
+	}
+	if err := validateAttrs(attributes); err != nil {
+		return fmt.Errorf("style: %w", err)
+	}
+
+	_, err = t.style(fromIdx, from, to, latestCreatedAtMapByActor, attributes, executedAt)
+	return err
+}
+
+// style applies the given attributes to the nodes of the given range, which
+// has been resolved to start at the given offset.
+func (t *Text) style(
+	fromIdx int,
+	from,
+	to *RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) (map[string]*time.Ticket, error) {
+	// 01. Split nodes with from and to
+	_, toRight, err := t.rgaTreeSplit.findNodeWithSplit(to, executedAt)
+	if err != nil {
+		return nil, err
+	}
+	_, fromRight, err := t.rgaTreeSplit.findNodeWithSplit(from, executedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// 02. style nodes between from and to except the ones inserted
+	// concurrently.
+	createdAtMapByActor := make(map[string]*time.Ticket)
+	nodes := t.rgaTreeSplit.findBetween(fromRight, toRight)
+	prevAttrs := t.attrsOf(nodes)
+	for _, node := range nodes {
+		actorIDHex := node.createdAt().ActorIDHex()
+		createdAt := node.createdAt()
+		if createdAt.After(latestCreatedAtOf(latestCreatedAtMapByActor, actorIDHex)) {
+			continue
+		}
+
+		if latest, ok := createdAtMapByActor[actorIDHex]; !ok || createdAt.After(latest) {
+			createdAtMapByActor[actorIDHex] = createdAt
+		}
+
+		val := node.value
+		for key, value := range attributes {
+			val.attrs.Set(key, value, executedAt)
+		}
+	}
+	t.notifyStyle(fromIdx, nodes, prevAttrs, attributes)
+	t.abandonTyping()
+
+	return createdAtMapByActor, nil
+}
+
+// StyleIfAbsent applies the given attributes of the given range only to the
+// nodes that don't have the attributes yet, so that the attributes set
+// explicitly are not overwritten by the default ones.
+func (t *Text) StyleIfAbsent(
+	from,
+	to *RGATreeSplitNodePos,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) error {
+	t.abandonTyping()
+
+	// 01. Split nodes with from and to
+	_, toRight, err := t.rgaTreeSplit.findNodeWithSplit(to, executedAt)
+	if err != nil {
+		return err
+	}
+	_, fromRight, err := t.rgaTreeSplit.findNodeWithSplit(from, executedAt)
+	if err != nil {
+		return err
+	}
+
+	// 02. style nodes between from and to if the attributes are absent
+	nodes := t.rgaTreeSplit.findBetween(fromRight, toRight)
+	for _, node := range nodes {
+		val := node.value
+		for key, value := range attributes {
+			if !val.attrs.Has(key) {
+				val.attrs.Set(key, value, executedAt)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RemoveStyle removes the given attributes of the given range. Like Style,
+// the nodes of the range inserted concurrently by other actors are kept as
+// they are, and the latest creation times by actor of the nodes whose
+// attributes are removed are returned to be recorded in the operation. It
+// returns an error without touching the nodes if the to position is before
+// the from position, or if either of them doesn't resolve to a node.
+func (t *Text) RemoveStyle(
+	from,
+	to *RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attributesToRemove []string,
+	executedAt *time.Ticket,
+) (map[string]*time.Ticket, error) {
+	fromIdx, err := t.rgaTreeSplit.posIndexOf(from)
+	if err != nil {
+		return nil, fmt.Errorf("remove style from: %w", err)
+	}
+	toIdx, err := t.rgaTreeSplit.posIndexOf(to)
+	if err != nil {
+		return nil, fmt.Errorf("remove style to: %w", err)
+	}
+	if toIdx < fromIdx {
+		return nil, fmt.Errorf("remove style range %d..%d: %w", fromIdx, toIdx, ErrInvalidRange)
+	}
+
+	return t.removeStyle(fromIdx, from, to, latestCreatedAtMapByActor, attributesToRemove, executedAt)
+}
+
+// ApplyRemoveStyle removes the given attributes of the given range like
+// RemoveStyle, but it is used to execute the RemoveStyle operations of the
+// other replicas. Like ApplyStyle, a range that doesn't resolve on this
+// replica is not an error.
+func (t *Text) ApplyRemoveStyle(
+	from,
+	to *RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attributesToRemove []string,
+	executedAt *time.Ticket,
+) error {
+	fromIdx, err := t.rgaTreeSplit.posIndexOf(from)
+	if err != nil {
+		return nil
+	}
+	toIdx, err := t.rgaTreeSplit.posIndexOf(to)
+	if err != nil || toIdx < fromIdx {
+		return nil
+	}
+
+	_, err = t.removeStyle(fromIdx, from, to, latestCreatedAtMapByActor, attributesToRemove, executedAt)
+	return err
+}
+
+// removeStyle removes the given attributes of the nodes of the given range,
+// which has been resolved to start at the given offset.
+func (t *Text) removeStyle(
+	fromIdx int,
+	from,
+	to *RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attributesToRemove []string,
+	executedAt *time.Ticket,
+) (map[string]*time.Ticket, error) {
+	// 01. Split nodes with from and to
+	_, toRight, err := t.rgaTreeSplit.findNodeWithSplit(to, executedAt)
+	if err != nil {
+		return nil, err
+	}
+	_, fromRight, err := t.rgaTreeSplit.findNodeWithSplit(from, executedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// 02. remove the attributes of nodes between from and to except the ones
+	// inserted concurrently.
+	createdAtMapByActor := make(map[string]*time.Ticket)
+	nodes := t.rgaTreeSplit.findBetween(fromRight, toRight)
+	prevAttrs := t.attrsOf(nodes)
+	for _, node := range nodes {
+		actorIDHex := node.createdAt().ActorIDHex()
+		createdAt := node.createdAt()
+		if createdAt.After(latestCreatedAtOf(latestCreatedAtMapByActor, actorIDHex)) {
+			continue
+		}
+
+		if latest, ok := createdAtMapByActor[actorIDHex]; !ok || createdAt.After(latest) {
+			createdAtMapByActor[actorIDHex] = createdAt
+		}
+
+		for _, key := range attributesToRemove {
+			node.value.attrs.Remove(key, executedAt)
+		}
+	}
+
+	keys := make(map[string]string, len(attributesToRemove))
+	for _, key := range attributesToRemove {
+		keys[key] = ""
+	}
+	t.notifyStyle(fromIdx, nodes, prevAttrs, keys)
+	t.abandonTyping()
+
+	return createdAtMapByActor, nil
+}
+
+// StyleAll applies the given attributes to the whole content of this Text.
+func (t *Text) StyleAll(attributes map[string]string, executedAt *time.Ticket) error {
+	from, to := t.CreateRange(0, t.Len())
+	_, err := t.Style(from, to, nil, attributes, executedAt)
+	return err
+}
+
+// ClearAllStyles removes all the attributes of the whole content of this
+// Text.
+func (t *Text) ClearAllStyles(executedAt *time.Ticket) error {
+	from, to := t.CreateRange(0, t.Len())
+	_, err := t.RemoveStyle(from, to, nil, t.StyleKeys(), executedAt)
+	return err
+}
+
+// StyleKeys returns the sorted keys of the attributes of the visible
+// content of this Text.
+func (t *Text) StyleKeys() []string {
+	keySet := make(map[string]bool)
+	for _, node := range t.Nodes() {
+		if node.removedAt != nil {
+			continue
+		}
+		for key := range node.value.attrs.Elements() {
+			keySet[key] = true
+		}
+	}
+
+	var keys []string
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Merge integrates the nodes of the given Text, which has been forked from
+// the same Text and edited independently, into this Text. The contents that
+// exist in both are deduplicated by their node IDs, and the concurrent
+// insertions are interleaved in the same order as the edits, so merging A
+// into B and merging B into A converge.
+func (t *Text) Merge(other *Text) error {
+	if t.createdAt.Compare(other.createdAt) != 0 {
+		return fmt.Errorf("merge text created at %s: %w", other.createdAt.Key(), ErrDifferentText)
+	}
+
+	return t.rgaTreeSplit.merge(other.rgaTreeSplit, func(dst, src *TextValue) {
+		dst.attrs.merge(src.attrs)
+	})
+}
+
+// Select stores that the given range has been selected.
+func (t *Text) Select(
+	from *RGATreeSplitNodePos,
+	to *RGATreeSplitNodePos,
+	executedAt *time.Ticket,
+) {
+	if prev, ok := t.selectionMap[executedAt.ActorIDHex()]; !ok || executedAt.After(prev.updatedAt) {
+		t.selectionMap[executedAt.ActorIDHex()] = newSelection(from, to, executedAt)
+	}
+}
+
+// EvictStaleSelections removes the selections last updated before the given
+// ticket and returns the number of removed selections. The selections are
+// kept per actor, so without the eviction a text edited by many actors keeps
+// the selections of the actors that have left.
+func (t *Text) EvictStaleSelections(before *time.Ticket) int {
+	count := 0
+	for actor, selection := range t.selectionMap {
+		if before.After(selection.updatedAt) {
+			delete(t.selectionMap, actor)
+			count++
+		}
+	}
+
+	return count
+}
+
+// SelectByOffset stores the selection of the given integer offsets like
+// Select. It returns an error if the offsets are out of the range of this Text.
+func (t *Text) SelectByOffset(from, to int, executedAt *time.Ticket) error {
+	fromPos, toPos, err := t.CreateRangeChecked(from, to)
+	if err != nil {
+		return err
+	}
+
+	t.Select(fromPos, toPos, executedAt)
+	return nil
+}
+
+// Substring returns the content of the given range of integer offsets. A
+// negative offset counts from the end of this Text like EditByOffset. The
+// range is widened so as not to split a surrogate pair, so a character out of
+// the Basic Multilingual Plane is returned whole.
+func (t *Text) Substring(from, to int) (string, error) {
+	from, to, err := t.relativeRange(from, to)
+	if err != nil {
+		return "", err
+	}
+	if to < from {
+		return "", fmt.Errorf("range %d..%d: %w", from, to, ErrInvalidRange)
+	}
+
+	encoded := utf16.Encode([]rune(t.String()))
+	if from > 0 && from < len(encoded) && utf16.IsSurrogate(rune(encoded[from])) && isHighSurrogate(encoded[from-1]) {
+		from--
+	}
+	if to > 0 && to < len(encoded) && isHighSurrogate(encoded[to-1]) {
+		to++
+	}
+
+	return string(utf16.Decode(encoded[from:to])), nil
+}
+
+// GetRangeText returns the visible content between the given positions, such
+// as the ones of a selection, without converting them to integer offsets. It
+// walks the nodes from the one of the from position to the one of the to
+// position, and takes the parts of the boundary nodes within the range. It
+// returns an error if either position doesn't resolve to a node, or if the to
+// position is before the from position.
+func (t *Text) GetRangeText(from, to *RGATreeSplitNodePos) (string, error) {
+	fromIdx, err := t.rgaTreeSplit.posIndexOf(from)
+	if err != nil {
+		return "", fmt.Errorf("range text from: %w", err)
+	}
+	toIdx, err := t.rgaTreeSplit.posIndexOf(to)
+	if err != nil {
+		return "", fmt.Errorf("range text to: %w", err)
+	}
+	if toIdx < fromIdx {
+		return "", fmt.Errorf("range text %d..%d: %w", fromIdx, toIdx, ErrInvalidRange)
+	}
+	if toIdx == fromIdx {
+		return "", nil
+	}
+
+	fromNode, fromOffset, _ := t.rgaTreeSplit.findPosNode(from)
+	toNode, toOffset, _ := t.rgaTreeSplit.findPosNode(to)
+
+	var units []uint16
+	for node := fromNode; node != nil; node = node.next {
+		if node.removedAt == nil {
+			encoded := utf16.Encode([]rune(node.String()))
+			start, end := 0, len(encoded)
+			if node == fromNode {
+				start = fromOffset
+			}
+			if node == toNode {
+				end = toOffset
+			}
+			units = append(units, encoded[start:end]...)
+		}
+		if node == toNode {
+			break
+		}
+	}
+
+	return string(utf16.Decode(units)), nil
+}
+
+// CharAt returns the character at the given integer offset. A negative offset
+// counts from the end of this Text like EditByOffset. If the offset points to
+// either half of a surrogate pair, the whole character is returned.
+func (t *Text) CharAt(offset int) (string, error) {
+	if offset < 0 {
+		offset += t.Len()
+	}
+	if offset < 0 || offset >= t.Len() {
+		return "", fmt.Errorf("char at %d: %w", offset, ErrOutOfRange)
+	}
+
+	return t.Substring(offset, offset+1)
+}
+
+// relativeRange returns the given range after counting the negative offsets
+// from the end of this Text.
+func (t *Text) relativeRange(from, to int) (int, int, error) {
+	length := t.Len()
+	if from < 0 {
+		from += length
+	}
+	if to < 0 {
+		to += length
+	}
+	if from < 0 || to < 0 || from > length || to > length {
+		return 0, 0, fmt.Errorf("range %d..%d of length %d: %w", from, to, length, ErrOutOfRange)
+	}
+
+	return from, to, nil
+}
+
+// isHighSurrogate returns whether the given code unit is the first half of a
+// surrogate pair.
+func isHighSurrogate(unit uint16) bool {
+	return unit >= 0xd800 && unit < 0xdc00
+}
+
+// LineCount returns the number of lines of this Text. Lines are separated
+// by '\n', so an empty text has one line.
+func (t *Text) LineCount() int {
+	return len(t.lineOffsets())
+}
+
+// LineAt returns the content of the given line without the separator.
+// The line is 0-based.
+func (t *Text) LineAt(line int) (string, error) {
+	offsets := t.lineOffsets()
+	if line < 0 || line >= len(offsets) {
+		return "", fmt.Errorf("line %d of %d lines: %w", line, len(offsets), ErrOutOfRange)
+	}
+
+	encoded := utf16.Encode([]rune(t.String()))
+	end := len(encoded)
+	if line+1 < len(offsets) {
+		end = offsets[line+1] - 1
+	}
+
+	return string(utf16.Decode(encoded[offsets[line]:end])), nil
+}
+
+// OffsetOfLine returns the integer offset of the beginning of the given
+// line. The line is 0-based.
+func (t *Text) OffsetOfLine(line int) (int, error) {
+	offsets := t.lineOffsets()
+	if line < 0 || line >= len(offsets) {
+		return 0, fmt.Errorf("line %d of %d lines: %w", line, len(offsets), ErrOutOfRange)
+	}
+
+	return offsets[line], nil
+}
+
+// Blocks returns the blocks of this Text for the block-based editors. The
+// blocks are separated by the block separator('\n'), and the attributes of
+// the separator are the block-level attributes of the block it ends, so the
+// block attributes are changed by styling the separator. The last block,
+// which has no separator, has no block-level attributes.
+//
+// The separators are a part of the content, so inserting text inside a block
+// keeps the blocks, inserting a separator splits a block, and removing a
+// separator merges the two blocks.
+func (t *Text) Blocks() []Block {
+	var blocks []Block
+
+	from, offset := 0, 0
+	node := t.rgaTreeSplit.initialHead.next
+	for node != nil {
+		if node.removedAt == nil {
+			for _, unit := range utf16.Encode([]rune(node.String())) {
+				if unit == '\n' {
+					blocks = append(blocks, Block{
+						From:       from,
+						To:         offset,
+						Attributes: node.value.attrs.Elements(),
+					})
+					from = offset + 1
+				}
+				offset++
+			}
+		}
+		node = node.next
+	}
+
+	return append(blocks, Block{From: from, To: offset})
+}
+
+// SplitBlock splits the block at the given integer offset by inserting the
+// block separator with the given block-level attributes. The attributes
+// belong to the block before the offset.
+func (t *Text) SplitBlock(offset int, attributes map[string]string, executedAt *time.Ticket) error {
+	return t.InsertAt(offset, "\n", attributes, executedAt)
+}
+
+// SplitAt splits the node containing the given position at the position,
+// and returns the right node of the split, which is nil if the position is at
+// the end of this Text. The content is not changed. It is a low-level API for
+// the tools repairing documents, so it returns an error if the position
+// doesn't resolve to a node or the node has been removed.
+func (t *Text) SplitAt(
+	pos *RGATreeSplitNodePos,
+	executedAt *time.Ticket,
+) (*RGATreeSplitNode[*TextValue], error) {
+	node, _, err := t.rgaTreeSplit.findPosNode(pos)
+	if err != nil {
+		return nil, fmt.Errorf("split at: %w", err)
+	}
+	if node.removedAt != nil {
+		return nil, fmt.Errorf("split at %s: %w", pos.StructureAsString(), ErrRemovedNode)
+	}
+
+	_, right, err := t.rgaTreeSplit.findNodeWithSplit(pos, executedAt)
+	if err != nil {
+		return nil, fmt.Errorf("split at: %w", err)
+	}
+	return right, nil
+}
+
+// TrailingWhitespaceRanges returns the integer ranges of the spaces and tabs
+// at the end of each line in the order of the lines. The ranges don't
+// include the line separators, and a line can span multiple nodes.
+func (t *Text) TrailingWhitespaceRanges() [][2]int {
+	var ranges [][2]int
+
+	encoded := utf16.Encode([]rune(t.String()))
+	from := -1
+	for offset, unit := range encoded {
+		switch unit {
+		case ' ', '\t':
+			if from < 0 {
+				from = offset
+			}
+		case '\n':
+			if from >= 0 {
+				ranges = append(ranges, [2]int{from, offset})
+			}
+			from = -1
+		default:
+			from = -1
+		}
+	}
+	if from >= 0 {
+		ranges = append(ranges, [2]int{from, len(encoded)})
+	}
+
+	return ranges
+}
+
+// IndexOf returns the integer offset of the first occurrence of the given
+// string at or after the given offset, or -1 if there is none. The content is
+// searched as a whole, so an occurrence can span multiple nodes.
+func (t *Text) IndexOf(search string, from int) int {
+	return indexOfUnits(utf16.Encode([]rune(t.String())), utf16.Encode([]rune(search)), from)
+}
+
+// IndexesOf returns the integer offsets of all the non-overlapping
+// occurrences of the given string from the beginning of this Text. An empty
+// string has no occurrences.
+func (t *Text) IndexesOf(search string) []int {
+	encoded := utf16.Encode([]rune(t.String()))
+	target := utf16.Encode([]rune(search))
+	if len(target) == 0 {
+		return nil
+	}
+
+	var offsets []int
+	for idx := indexOfUnits(encoded, target, 0); idx >= 0; idx = indexOfUnits(encoded, target, idx+len(target)) {
+		offsets = append(offsets, idx)
+	}
+
+	return offsets
+}
+
+// indexOfUnits returns the index of the first occurrence of the target in
+// the given code units at or after the given index, or -1 if there is none.
+func indexOfUnits(units, target []uint16, from int) int {
+	if from < 0 {
+		from = 0
+	}
+
+	for i := from; i+len(target) <= len(units); i++ {
+		matched := true
+		for j, unit := range target {
+			if units[i+j] != unit {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// lineOffsets returns the offsets of the beginning of each line. A line can
+// span multiple nodes, so the offsets are accumulated across the nodes.
+func (t *Text) lineOffsets() []int {
+	offsets := []int{0}
+
+	offset := 0
+	node := t.rgaTreeSplit.initialHead.next
+	for node != nil {
+		if node.removedAt == nil {
+			for _, unit := range utf16.Encode([]rune(node.String())) {
+				offset++
+				if unit == '\n' {
+					offsets = append(offsets, offset)
+				}
+			}
+		}
+		node = node.next
+	}
+
+	return offsets
+}
+
+// Nodes returns the internal nodes of this Text.
+func (t *Text) Nodes() []*RGATreeSplitNode[*TextValue] {
+	return t.rgaTreeSplit.nodes()
+}
+
+// AttributeKeys returns the sorted keys of the attributes used by the live
+// nodes of this Text, e.g. to build a formatting toolbar without a schema.
+func (t *Text) AttributeKeys() []string {
+	keySet := make(map[string]bool)
+	for _, node := range t.VisibleNodes() {
+		for key := range node.value.attrs.Elements() {
+			keySet[key] = true
+		}
+	}
+
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// VisibleNodes returns the live nodes of this Text in the order of the
+// content, which are the nodes that String and Marshal write. Use Nodes to
+// get the removed nodes as well.
+func (t *Text) VisibleNodes() []*RGATreeSplitNode[*TextValue] {
+	var nodes []*RGATreeSplitNode[*TextValue]
+
+	node := t.rgaTreeSplit.initialHead.next
+	for node != nil {
+		if node.createdAt().Compare(t.createdAt) == 0 {
+			// last line
+		} else if node.removedAt == nil {
+			nodes = append(nodes, node)
+		}
+		node = node.next
+	}
+
+	return nodes
+}
+
+// ForEachReverse calls the given function for the live nodes of this Text
+// from the end to the beginning, following the previous links of the nodes.
+// The iteration stops when the function returns true.
+func (t *Text) ForEachReverse(fn func(node *RGATreeSplitNode[*TextValue]) bool) {
+	node := t.rgaTreeSplit.lastNode()
+	for node != t.rgaTreeSplit.initialHead {
+		if node.createdAt().Compare(t.createdAt) == 0 {
+			// last line
+		} else if node.removedAt == nil && fn(node) {
+			return
+		}
+		node = node.prev
+	}
+}
+
+// StructureAsString returns a String containing the metadata of the text
+// for debugging purpose. The format is stable, so it can be used to pin the
+// internal state of the text in tests.
+//
+// The nodes, including the initial head, are written in the order of the
+// list. A live node is written as `[ID ATTRS CONTENT]` and a removed node as
+// `{ID ATTRS CONTENT}`, where
+//   - ID is `LAMPORT:DELIMITER:ACTOR:OFFSET`, and ACTOR is the last two hex
+//     digits of the actor ID,
+//   - ATTRS is the JSON of the attributes with the keys sorted,
+//   - CONTENT is the quoted and escaped content, or the JSON of the payload
+//     of an embedded object.
+//
+// The previous node at the insertion(insPrev) is not written, because it is
+// always the node of the same creation time that ends at OFFSET if OFFSET
+// is not 0.
+func (t *Text) StructureAsString() string {
+	return t.rgaTreeSplit.StructureAsString()
+}
+
+// MarshalByID returns the JSON encoding of all the nodes of this Text,
+// including the removed ones, ordered by their IDs instead of the order of
+// the list. It is for the tools investigating the concurrent states, so the
+// output is deterministic regardless of the order of the edits applied.
+//
+// Each node is encoded as `{"id":ID,"insPrev":ID,"removed":BOOL,"value":VALUE}`,
+// where ID is `LAMPORT:DELIMITER:ACTOR:OFFSET` with the full hex of the
+// actor, insPrev is null if the node has no previous node at the insertion,
+// and VALUE is the JSON encoding of the value as in Marshal.
+func (t *Text) MarshalByID() string {
+	nodes := t.Nodes()
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].id.Compare(nodes[j].id) < 0
+	})
+
+	sb := strings.Builder{}
+	sb.WriteString("[")
+	for idx, node := range nodes {
+		if idx > 0 {
+			sb.WriteString(",")
+		}
+
+		insPrev := "null"
+		if node.insPrev != nil {
+			insPrev = fmt.Sprintf(`"%s"`, node.insPrev.id.key())
+		}
+		sb.WriteString(fmt.Sprintf(
+			`{"id":"%s","insPrev":%s,"removed":%t,"value":%s}`,
+			node.id.key(),
+			insPrev,
+			node.removedAt != nil,
+			node.value.Marshal(),
+		))
+	}
+	sb.WriteString("]")
+
+	return sb.String()
+}
+
+// CheckWeight returns false when there is an incorrect weight node.
+// for debugging purpose.
+func (t *Text) CheckWeight() bool {
+	return t.rgaTreeSplit.CheckWeight()
+}
+
+// RemoveEmptyNodes physically removes the live nodes whose content is empty
+// and returns the number of the removed nodes. Unlike GC, which purges the
+// removed nodes, it cleans up the nodes that take slots of the trees without
+// being eligible for GC. The visible content is not changed, but positions
+// pointing to the removed nodes no longer resolve like purged ones.
+func (t *Text) RemoveEmptyNodes() int {
+	return t.rgaTreeSplit.removeEmptyNodes()
+}
+
+// CheckLinks returns false when the links between the nodes are
+// inconsistent. for debugging purpose.
+func (t *Text) CheckLinks() bool {
+	return t.rgaTreeSplit.CheckLinks()
+}
+
+// TotalWeight returns the length of the content of all nodes including the
+// removed ones that have not been purged yet. The removed nodes have no
+// weight in the index tree, so their lengths are added to the weight of the
+// root of the tree. The difference from Len is the length that can be
+// reclaimed by Purge.
+func (t *Text) TotalWeight() int {
+	weight := t.rgaTreeSplit.treeByIndex.Len()
+	for _, node := range t.rgaTreeSplit.removedNodeMap {
+		weight += node.contentLen()
+	}
+
+	return weight
+}
+
+// TreeStats returns the statistics of the index tree of this Text.
+func (t *Text) TreeStats() TreeStats {
+	return TreeStats{
+		Height:    t.rgaTreeSplit.treeByIndex.Height(),
+		NodeCount: t.rgaTreeSplit.treeByIndex.Size(),
+	}
+}
+
+// NodeCount returns the number of the live nodes and the removed nodes of
+// this Text, not counting the initial head. Many nodes for the length mean
+// that the text is fragmented by the edits.
+func (t *Text) NodeCount() (live, removed int) {
+	node := t.rgaTreeSplit.initialHead.next
+	for node != nil {
+		if node.removedAt == nil {
+			live++
+		} else {
+			removed++
+		}
+		node = node.next
+	}
+
+	return live, removed
+}
+
+// AvgNodeLength returns the average length of the live nodes of this Text in
+// UTF-16 code units, or 0 if there is no live node. The shorter it is, the
+// more fragmented the text is.
+func (t *Text) AvgNodeLength() float64 {
+	live, _ := t.NodeCount()
+	if live == 0 {
+		return 0
+	}
+
+	return float64(t.Len()) / float64(live)
+}
+
+// Rebalance rebuilds the index tree of this Text into a balanced tree. The
+// content and the IDs of the nodes are not changed. It is useful when the
+// tree has degraded by the append-heavy edits, which make the lookups of
+// the positions slow.
+func (t *Text) Rebalance() {
+	t.rgaTreeSplit.treeByIndex.Rebalance()
+}
+
+// removedNodesLen returns length of removed nodes
+func (t *Text) removedNodesLen() int {
+	return t.rgaTreeSplit.removedNodesLen()
+}
+
+// Purge physically purges the nodes that have been removed before the given
+// time, and returns what has been reclaimed.
+func (t *Text) Purge(ticket *time.Ticket) PurgeResult {
+	return t.purgeTextNodesWithGarbage(ticket)
+}
+
+// purgeTextNodesWithGarbage physically purges nodes that have been removed.
+func (t *Text) purgeTextNodesWithGarbage(ticket *time.Ticket) PurgeResult {
+	result := PurgeResult{}
+	for _, node := range t.rgaTreeSplit.purgeTextNodesWithGarbage(ticket) {
+		result.Nodes++
+		result.CodeUnits += node.value.Len()
+		result.AttrEntries += len(node.value.attrs.Nodes())
+	}
+
+	return result
 }
@@ -0,0 +1,467 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"unicode/utf16"
+)
+
+// textWatchBufferSize is the size of the buffer of the channel returned by
+// Text.Watch.
+const textWatchBufferSize = 256
+
+// TextChangeType is the type of TextChange.
+type TextChangeType string
+
+// The values below are types of TextChange.
+const (
+	// TextInsertChange means that the content has been inserted.
+	TextInsertChange TextChangeType = "insert"
+
+	// TextDeleteChange means that the content has been deleted.
+	TextDeleteChange TextChangeType = "delete"
+
+	// TextStyleChange means that the attributes of the content have been
+	// changed.
+	TextStyleChange TextChangeType = "style"
+
+	// TextReplaceChange means that the content of the range has been
+	// replaced with the new content.
+	TextReplaceChange TextChangeType = "replace"
+)
+
+// TextChange represents a change of Text. From and To are integer offsets
+// in UTF-16 code units of the Text to which the preceding changes of the
+// same change set have already been applied.
+//
+// Attributes are the attributes of the inserted content, or the new
+// attributes of the range of a style change. PrevAttributes are the values of
+// the same attributes before a style change, and the attributes that were
+// absent are omitted. RemovedAttributes are the sorted keys of the attributes
+// removed from the range of a style change.
+type TextChange struct {
+	Type              TextChangeType
+	From              int
+	To                int
+	Content           string
+	Attributes        map[string]string
+	PrevAttributes    map[string]string
+	RemovedAttributes []string
+}
+
+// newEditChange returns the change of the edit that replaces the given range
+// with the given content. An edit of an empty range is an insertion, an edit
+// with an empty content is a deletion, and the others are replacements. It
+// returns false if the edit changes nothing.
+func newEditChange(from, to int, content string, attributes map[string]string) (TextChange, bool) {
+	change := TextChange{
+		From:       from,
+		To:         to,
+		Content:    content,
+		Attributes: attributes,
+	}
+
+	switch {
+	case from == to && content == "":
+		return change, false
+	case from == to:
+		change.Type = TextInsertChange
+	case content == "":
+		change.Type = TextDeleteChange
+		change.Attributes = nil
+	default:
+		change.Type = TextReplaceChange
+	}
+
+	return change, true
+}
+
+// OnChange registers the given function to be called with the change of
+// each edit of this Text. The registered function is not copied by
+// DeepCopy.
+func (t *Text) OnChange(fn func(change TextChange)) {
+	t.onChange = fn
+}
+
+// Watch returns a channel that streams the change of each edit of this Text
+// until the given context is done, and then the channel is closed. It works
+// alongside the function registered by OnChange.
+//
+// The channel has a bounded buffer, and editing never blocks on a slow
+// consumer: if the buffer is full, the change is dropped. Consumers that
+// can't afford to miss a change should use OnChange instead. The context
+// should be cancelled when the channel is no longer read, or the watcher is
+// kept until this Text is dropped.
+func (t *Text) Watch(ctx context.Context) <-chan TextChange {
+	w := &textWatcher{ch: make(chan TextChange, textWatchBufferSize)}
+	t.watchers = append(t.watchers, w)
+
+	go func() {
+		<-ctx.Done()
+		w.close()
+	}()
+
+	return w.ch
+}
+
+// textWatcher is a consumer of the changes of Text registered by Watch.
+type textWatcher struct {
+	mu     sync.Mutex
+	ch     chan TextChange
+	closed bool
+}
+
+// send sends the given change to the channel without blocking. It returns
+// false if the watcher has been closed.
+func (w *textWatcher) send(change TextChange) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return false
+	}
+
+	select {
+	case w.ch <- change:
+	default:
+	}
+	return true
+}
+
+// close closes the channel of the watcher.
+func (w *textWatcher) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.closed {
+		w.closed = true
+		close(w.ch)
+	}
+}
+
+// hasListeners returns whether a function is registered by OnChange or a
+// channel is returned by Watch, so that the changes need to be computed.
+func (t *Text) hasListeners() bool {
+	return t.onChange != nil || len(t.watchers) > 0
+}
+
+// emit delivers the given change to the function registered by OnChange and
+// the watchers. The closed watchers are dropped.
+func (t *Text) emit(change TextChange) {
+	if t.onChange != nil {
+		t.onChange(change)
+	}
+
+	watchers := t.watchers[:0]
+	for _, w := range t.watchers {
+		if w.send(change) {
+			watchers = append(watchers, w)
+		}
+	}
+	for i := len(watchers); i < len(t.watchers); i++ {
+		t.watchers[i] = nil
+	}
+	t.watchers = watchers
+}
+
+// notifyEdit notifies the registered function of the edit of the given range
+// with the given content. The range is the integer offsets before the edit.
+func (t *Text) notifyEdit(from, to int, content string, attributes map[string]string) {
+	if !t.hasListeners() {
+		return
+	}
+
+	if change, ok := newEditChange(from, to, content, attributes); ok {
+		t.emit(change)
+	}
+}
+
+// attrsOf returns the attributes of the given nodes if a function is
+// registered by OnChange. It is used to capture the attributes before a
+// style change.
+func (t *Text) attrsOf(nodes []*RGATreeSplitNode[*TextValue]) []map[string]string {
+	if !t.hasListeners() {
+		return nil
+	}
+
+	attrs := make([]map[string]string, len(nodes))
+	for i, node := range nodes {
+		attrs[i] = node.value.attrs.Elements()
+	}
+	return attrs
+}
+
+// notifyStyle notifies the registered function of the style change of the
+// given nodes starting at the given integer offset. The adjacent nodes whose
+// attributes have been changed in the same way are notified as a single
+// change, and the nodes whose attributes have not been changed are skipped.
+func (t *Text) notifyStyle(
+	from int,
+	nodes []*RGATreeSplitNode[*TextValue],
+	prevAttrs []map[string]string,
+	attributes map[string]string,
+) {
+	if !t.hasListeners() {
+		return
+	}
+
+	var changes []TextChange
+	pos := from
+	for i, node := range nodes {
+		if node.removedAt != nil {
+			continue
+		}
+
+		prev := pickAttrs(prevAttrs[i], attributes)
+		next := pickAttrs(node.value.attrs.Elements(), attributes)
+		length := node.contentLen()
+		if !equalAttrs(prev, next) {
+			last := len(changes) - 1
+			if last >= 0 && changes[last].To == pos &&
+				equalAttrs(changes[last].PrevAttributes, prev) &&
+				equalAttrs(changes[last].Attributes, next) {
+				changes[last].To += length
+			} else {
+				changes = append(changes, TextChange{
+					Type:              TextStyleChange,
+					From:              pos,
+					To:                pos + length,
+					Attributes:        next,
+					PrevAttributes:    prev,
+					RemovedAttributes: removedAttrs(prev, next),
+				})
+			}
+		}
+		pos += length
+	}
+
+	for _, change := range changes {
+		t.emit(change)
+	}
+}
+
+// pickAttrs returns the attributes of the given keys.
+func pickAttrs(attrs map[string]string, keys map[string]string) map[string]string {
+	picked := make(map[string]string)
+	for key := range keys {
+		if value, ok := attrs[key]; ok {
+			picked[key] = value
+		}
+	}
+	return picked
+}
+
+// offsetsOf returns the integer offsets of the given range if a function is
+// registered by OnChange.
+func (t *Text) offsetsOf(from, to *RGATreeSplitNodePos) (int, int) {
+	if !t.hasListeners() {
+		return 0, 0
+	}
+
+	fromIdx, err := t.rgaTreeSplit.indexOf(from.getAbsoluteID())
+	if err != nil {
+		return 0, 0
+	}
+	toIdx, err := t.rgaTreeSplit.indexOf(to.getAbsoluteID())
+	if err != nil {
+		return fromIdx, fromIdx
+	}
+
+	return fromIdx, toIdx
+}
+
+// textUnit is a UTF-16 code unit of Text with its CRDT identity.
+type textUnit struct {
+	key   string
+	unit  uint16
+	attrs map[string]string
+}
+
+// DiffText returns the changes that transform the given `before` Text into
+// the given `after` Text. The contents of both Texts are aligned by the
+// identity of the nodes, not by the contents, so `before` and `after` are
+// expected to be the snapshots of the same Text.
+func DiffText(before, after *Text) []TextChange {
+	beforeUnits := before.units()
+	afterUnits := after.units()
+
+	beforeKeys := make(map[string]bool, len(beforeUnits))
+	for _, u := range beforeUnits {
+		beforeKeys[u.key] = true
+	}
+	afterKeys := make(map[string]bool, len(afterUnits))
+	for _, u := range afterUnits {
+		afterKeys[u.key] = true
+	}
+
+	var changes []TextChange
+	i, j, pos := 0, 0, 0
+	for i < len(beforeUnits) || j < len(afterUnits) {
+		// 01. Collect the units that exist only in `before` as a deletion.
+		if i < len(beforeUnits) && !afterKeys[beforeUnits[i].key] {
+			start := i
+			for i < len(beforeUnits) && !afterKeys[beforeUnits[i].key] {
+				i++
+			}
+			changes = append(changes, TextChange{
+				Type: TextDeleteChange,
+				From: pos,
+				To:   pos + i - start,
+			})
+			continue
+		}
+
+		// 02. Collect the units that exist only in `after` as an insertion.
+		if j < len(afterUnits) && !beforeKeys[afterUnits[j].key] {
+			start := j
+			var units []uint16
+			for j < len(afterUnits) && !beforeKeys[afterUnits[j].key] &&
+				equalAttrs(afterUnits[start].attrs, afterUnits[j].attrs) {
+				units = append(units, afterUnits[j].unit)
+				j++
+			}
+			changes = append(changes, TextChange{
+				Type:       TextInsertChange,
+				From:       pos,
+				To:         pos,
+				Content:    string(utf16.Decode(units)),
+				Attributes: afterUnits[start].attrs,
+			})
+			pos += j - start
+			continue
+		}
+
+		// 03. The units that exist in both are compared by their attributes.
+		if i >= len(beforeUnits) || j >= len(afterUnits) {
+			break
+		}
+
+		// NOTE: The common units are always in the same order in both Texts
+		// if they are the snapshots of the same Text. Otherwise, the units
+		// out of order are handled as a deletion and an insertion.
+		if beforeUnits[i].key != afterUnits[j].key {
+			for _, key := range []string{beforeUnits[i].key, afterUnits[j].key} {
+				delete(beforeKeys, key)
+				delete(afterKeys, key)
+			}
+			continue
+		}
+
+		attrs := changedAttrs(beforeUnits[i].attrs, afterUnits[j].attrs)
+		removed := removedAttrs(beforeUnits[i].attrs, afterUnits[j].attrs)
+		if len(attrs) == 0 && len(removed) == 0 {
+			i, j, pos = i+1, j+1, pos+1
+			continue
+		}
+
+		start := pos
+		for i < len(beforeUnits) && j < len(afterUnits) &&
+			beforeUnits[i].key == afterUnits[j].key &&
+			equalAttrs(attrs, changedAttrs(beforeUnits[i].attrs, afterUnits[j].attrs)) &&
+			equalKeys(removed, removedAttrs(beforeUnits[i].attrs, afterUnits[j].attrs)) {
+			i, j, pos = i+1, j+1, pos+1
+		}
+		changes = append(changes, TextChange{
+			Type:              TextStyleChange,
+			From:              start,
+			To:                pos,
+			Attributes:        attrs,
+			RemovedAttributes: removed,
+		})
+	}
+
+	return changes
+}
+
+// units returns the visible UTF-16 code units of this Text with the
+// identity of each unit.
+func (t *Text) units() []textUnit {
+	var units []textUnit
+
+	node := t.rgaTreeSplit.initialHead.next
+	for node != nil {
+		if node.removedAt == nil {
+			prefix := node.createdAt().Key() + ":"
+			attrs := node.value.attrs.Elements()
+			for i, u := range utf16.Encode([]rune(node.String())) {
+				units = append(units, textUnit{
+					key:   prefix + strconv.Itoa(node.id.offset+i),
+					unit:  u,
+					attrs: attrs,
+				})
+			}
+		}
+		node = node.next
+	}
+
+	return units
+}
+
+// changedAttrs returns the attributes of `after` whose values are different
+// from `before`.
+func changedAttrs(before, after map[string]string) map[string]string {
+	changed := make(map[string]string)
+	for key, value := range after {
+		if prev, ok := before[key]; !ok || prev != value {
+			changed[key] = value
+		}
+	}
+	return changed
+}
+
+// removedAttrs returns the sorted keys of the attributes of `before` that
+// `after` doesn't have, or nil if there are none.
+func removedAttrs(before, after map[string]string) []string {
+	var removed []string
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// equalKeys returns whether the given keys are the same or not.
+func equalKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// equalAttrs returns whether the given attributes are the same or not.
+func equalAttrs(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if other, ok := b[key]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
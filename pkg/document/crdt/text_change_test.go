@@ -0,0 +1,325 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt_test
+
+import (
+	"context"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestDiffText(t *testing.T) {
+	t.Run("pure insert test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello World", nil, ctx.IssueTimeTicket())
+		before := text.DeepCopy().(*crdt.Text)
+
+		fromPos, toPos = text.CreateRange(5, 5)
+		text.Edit(fromPos, toPos, nil, ",", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(12, 12)
+		text.Edit(fromPos, toPos, nil, "!", map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.Equal(t, "Hello, World!", text.String())
+
+		assert.Equal(t, []crdt.TextChange{
+			{Type: crdt.TextInsertChange, From: 5, To: 5, Content: ",", Attributes: map[string]string{}},
+			{Type: crdt.TextInsertChange, From: 12, To: 12, Content: "!", Attributes: map[string]string{"b": "1"}},
+		}, crdt.DiffText(before, text))
+	})
+
+	t.Run("pure delete test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello World", nil, ctx.IssueTimeTicket())
+		before := text.DeepCopy().(*crdt.Text)
+
+		fromPos, toPos = text.CreateRange(0, 1)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(4, 10)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "ello", text.String())
+
+		assert.Equal(t, []crdt.TextChange{
+			{Type: crdt.TextDeleteChange, From: 0, To: 1},
+			{Type: crdt.TextDeleteChange, From: 4, To: 10},
+		}, crdt.DiffText(before, text))
+	})
+
+	t.Run("style only test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello World", nil, ctx.IssueTimeTicket())
+		before := text.DeepCopy().(*crdt.Text)
+
+		fromPos, toPos = text.CreateRange(0, 5)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(3, 8)
+		_, err = text.Style(fromPos, toPos, nil, map[string]string{"i": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		assert.Equal(t, []crdt.TextChange{
+			{Type: crdt.TextStyleChange, From: 0, To: 3, Attributes: map[string]string{"b": "1"}},
+			{Type: crdt.TextStyleChange, From: 3, To: 5, Attributes: map[string]string{"b": "1", "i": "1"}},
+			{Type: crdt.TextStyleChange, From: 5, To: 8, Attributes: map[string]string{"i": "1"}},
+		}, crdt.DiffText(before, text))
+		assert.Len(t, crdt.DiffText(text, text), 0)
+	})
+
+	t.Run("style removal test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello World", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(0, 8)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1", "i": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		before := text.DeepCopy().(*crdt.Text)
+
+		var changes []crdt.TextChange
+		text.OnChange(func(change crdt.TextChange) {
+			changes = append(changes, change)
+		})
+
+		// 01. The removed keys are reported by the notified changes.
+		fromPos, toPos = text.CreateRange(0, 5)
+		_, err = text.RemoveStyle(fromPos, toPos, nil, []string{"b"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(3, 5)
+		_, err = text.RemoveStyle(fromPos, toPos, nil, []string{"i", "u"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, []crdt.TextChange{
+			{
+				Type: crdt.TextStyleChange, From: 0, To: 5,
+				Attributes:        map[string]string{},
+				PrevAttributes:    map[string]string{"b": "1"},
+				RemovedAttributes: []string{"b"},
+			},
+			{
+				Type: crdt.TextStyleChange, From: 3, To: 5,
+				Attributes:        map[string]string{},
+				PrevAttributes:    map[string]string{"i": "1"},
+				RemovedAttributes: []string{"i"},
+			},
+		}, changes)
+
+		// 02. The removed keys are reported by the diff of the snapshots.
+		assert.Equal(t, []crdt.TextChange{
+			{
+				Type: crdt.TextStyleChange, From: 0, To: 3,
+				Attributes:        map[string]string{},
+				RemovedAttributes: []string{"b"},
+			},
+			{
+				Type: crdt.TextStyleChange, From: 3, To: 5,
+				Attributes:        map[string]string{},
+				RemovedAttributes: []string{"b", "i"},
+			},
+		}, crdt.DiffText(before, text))
+	})
+
+	t.Run("edit change type test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		var changes []crdt.TextChange
+		text.OnChange(func(change crdt.TextChange) {
+			changes = append(changes, change)
+		})
+
+		edit := func(from, to int, content string) {
+			fromPos, toPos := text.CreateRange(from, to)
+			text.Edit(fromPos, toPos, nil, content, nil, ctx.IssueTimeTicket())
+		}
+		edit(0, 0, "Hello World")
+		edit(5, 11, "")
+		edit(0, 1, "J")
+		edit(2, 2, "")
+		assert.Equal(t, "Jello", text.String())
+
+		assert.Equal(t, []crdt.TextChange{
+			{Type: crdt.TextInsertChange, From: 0, To: 0, Content: "Hello World"},
+			{Type: crdt.TextDeleteChange, From: 5, To: 11},
+			{Type: crdt.TextReplaceChange, From: 0, To: 1, Content: "J"},
+		}, changes)
+	})
+
+	t.Run("attribute deltas of change test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		var changes []crdt.TextChange
+		text.OnChange(func(change crdt.TextChange) {
+			changes = append(changes, change)
+		})
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello", map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(5, 5)
+		text.Edit(fromPos, toPos, nil, " World", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(3, 8)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "2", "i": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(0, 8)
+		_, err = text.Style(fromPos, toPos, nil, map[string]string{"i": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		assert.Equal(t, []crdt.TextChange{
+			{
+				Type: crdt.TextInsertChange, From: 0, To: 0, Content: "Hello",
+				Attributes: map[string]string{"b": "1"},
+			},
+			{Type: crdt.TextInsertChange, From: 5, To: 5, Content: " World"},
+			{
+				Type: crdt.TextStyleChange, From: 3, To: 5,
+				Attributes:     map[string]string{"b": "2", "i": "1"},
+				PrevAttributes: map[string]string{"b": "1"},
+			},
+			{
+				Type: crdt.TextStyleChange, From: 5, To: 8,
+				Attributes:     map[string]string{"b": "2", "i": "1"},
+				PrevAttributes: map[string]string{},
+			},
+			{
+				Type: crdt.TextStyleChange, From: 0, To: 3,
+				Attributes:     map[string]string{"i": "1"},
+				PrevAttributes: map[string]string{},
+			},
+		}, changes)
+	})
+
+	t.Run("transaction test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		var notified []crdt.TextChange
+		text.OnChange(func(change crdt.TextChange) {
+			notified = append(notified, change)
+		})
+
+		// 01. The changes of a transaction are coalesced and notified at once.
+		changes, err := text.Transaction(func(tx *crdt.TextTx) error {
+			for i, c := range []string{"a", "b", "c"} {
+				if err := tx.Edit(i, i, c, nil, ctx.IssueTimeTicket()); err != nil {
+					return err
+				}
+			}
+			assert.Len(t, notified, 0)
+			return tx.Style(0, 3, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		})
+		assert.NoError(t, err)
+		expected := []crdt.TextChange{
+			{Type: crdt.TextInsertChange, From: 0, To: 0, Content: "abc"},
+			{
+				Type: crdt.TextStyleChange, From: 0, To: 3,
+				Attributes:     map[string]string{"b": "1"},
+				PrevAttributes: map[string]string{},
+			},
+		}
+		assert.Equal(t, expected, changes)
+		assert.Equal(t, expected, notified)
+		marshaled := text.Marshal()
+
+		// 02. The transaction failed in the middle is rolled back.
+		changes, err = text.Transaction(func(tx *crdt.TextTx) error {
+			if err := tx.Edit(3, 3, "d", nil, ctx.IssueTimeTicket()); err != nil {
+				return err
+			}
+			return tx.Style(0, 10, map[string]string{"i": "1"}, ctx.IssueTimeTicket())
+		})
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		assert.Nil(t, changes)
+		assert.Equal(t, expected, notified)
+		assert.Equal(t, marshaled, text.Marshal())
+		assert.Equal(t, 3, text.Len())
+		assert.True(t, text.CheckWeight())
+
+		fromPos, toPos := text.CreateRange(3, 3)
+		text.Edit(fromPos, toPos, nil, "d", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "abcd", text.String())
+		assert.Len(t, notified, 3)
+	})
+
+	t.Run("watch test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		var notified []crdt.TextChange
+		text.OnChange(func(change crdt.TextChange) {
+			notified = append(notified, change)
+		})
+		watchCtx, cancel := context.WithCancel(context.Background())
+		ch := text.Watch(watchCtx)
+
+		// 01. the changes are streamed alongside OnChange.
+		assert.NoError(t, text.Append("abc", nil, ctx.IssueTimeTicket()))
+		fromPos, toPos := text.CreateRange(0, 1)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, crdt.TextChange{Type: crdt.TextInsertChange, Content: "abc"}, <-ch)
+		assert.Equal(t, crdt.TextChange{Type: crdt.TextDeleteChange, From: 0, To: 1}, <-ch)
+		assert.Len(t, notified, 2)
+
+		// 02. the changes are dropped instead of blocking a slow consumer.
+		for i := 0; i < 300; i++ {
+			assert.NoError(t, text.Append("d", nil, ctx.IssueTimeTicket()))
+		}
+		assert.Len(t, notified, 302)
+
+		// 03. cancelling the context closes the channel and stops delivery.
+		cancel()
+		received := 0
+		timeout := gotime.After(gotime.Second)
+		for closed := false; !closed; {
+			select {
+			case _, ok := <-ch:
+				if ok {
+					received++
+				} else {
+					closed = true
+				}
+			case <-timeout:
+				t.Fatal("the channel is not closed")
+			}
+		}
+		assert.LessOrEqual(t, received, 300)
+
+		assert.NoError(t, text.Append("e", nil, ctx.IssueTimeTicket()))
+		_, ok := <-ch
+		assert.False(t, ok)
+		assert.Len(t, notified, 303)
+	})
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"errors"
+	"fmt"
+)
+
+// LockAttribute is the attribute that marks the characters of Text as
+// locked. As it is an attribute like the others, locking and unlocking are
+// replicated by Style, and the concurrent ones are resolved by the last
+// writer like the other attributes.
+const LockAttribute = "$locked"
+
+// lockedValue is the value of LockAttribute of the locked characters.
+const lockedValue = "true"
+
+// ErrLockedRange is returned when an edit touches a locked range of Text.
+var ErrLockedRange = errors.New("locked range")
+
+// LockAttributes returns the attributes that lock or unlock the styled
+// range.
+func LockAttributes(locked bool) map[string]string {
+	if locked {
+		return map[string]string{LockAttribute: lockedValue}
+	}
+	return map[string]string{LockAttribute: "false"}
+}
+
+// IsLocked returns whether the character at the given integer offset is
+// locked.
+func (t *Text) IsLocked(offset int) bool {
+	attrs := t.attributesOfChar(offset)
+	return attrs != nil && attrs[LockAttribute] == lockedValue
+}
+
+// CheckLocked returns an error if the edit of the given range of integer
+// offsets touches a locked range: if the range contains a locked character,
+// or if the range is empty and between two locked characters. Inserting at
+// the edges of a locked range is allowed.
+//
+// The lock is only checked by the local edits. The remote edits are applied
+// regardless of it, so the replicas converge even if a range is locked
+// concurrently with an edit of it.
+func (t *Text) CheckLocked(from, to int) error {
+	if from == to {
+		if t.IsLocked(from-1) && t.IsLocked(from) {
+			return fmt.Errorf("insert at %d: %w", from, ErrLockedRange)
+		}
+		return nil
+	}
+
+	for offset := from; offset < to; offset++ {
+		if t.IsLocked(offset) {
+			return fmt.Errorf("edit %d..%d at %d: %w", from, to, offset, ErrLockedRange)
+		}
+	}
+
+	return nil
+}
+
+// withoutLock returns the given attributes without LockAttribute, so that
+// the content inserted next to a locked range doesn't inherit the lock.
+func withoutLock(attrs map[string]string) map[string]string {
+	if _, ok := attrs[LockAttribute]; !ok {
+		return attrs
+	}
+
+	result := make(map[string]string, len(attrs)-1)
+	for k, v := range attrs {
+		if k != LockAttribute {
+			result[k] = v
+		}
+	}
+	return result
+}
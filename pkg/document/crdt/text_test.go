@@ -17,11 +17,19 @@
 package crdt_test
 
 import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -77,11 +85,1700 @@ func TestText(t *testing.T) {
 		assert.Equal(t, `[{"val":"Hello "},{"val":"Yorkie"}]`, text.Marshal())
 
 		fromPos, toPos = text.CreateRange(0, 1)
-		text.Style(fromPos, toPos, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
 		assert.Equal(
 			t,
 			`[{"attrs":{"b":"1"},"val":"H"},{"val":"ello "},{"val":"Yorkie"}]`,
 			text.Marshal(),
 		)
 	})
+
+	t.Run("embed test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hi !", nil, ctx.IssueTimeTicket())
+
+		fromPos, toPos = text.CreateRange(3, 3)
+		text.EditEmbed(fromPos, toPos, nil, map[string]string{"mention": "yorkie"}, nil, ctx.IssueTimeTicket())
+		assert.Equal(t, `[{"val":"Hi "},{"embed":{"mention":"yorkie"}},{"val":"!"}]`, text.Marshal())
+		assert.Equal(t, "Hi \uFFFC!", text.String())
+
+		// An embed can be split at its boundaries, but not inside.
+		fromPos, toPos = text.CreateRange(4, 4)
+		text.Edit(fromPos, toPos, nil, " :)", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "Hi \uFFFC :)!", text.String())
+
+		fromPos, toPos = text.CreateRange(3, 4)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			`[{"val":"Hi "},{"attrs":{"b":"1"},"embed":{"mention":"yorkie"}},{"val":" :)"},{"val":"!"}]`,
+			text.Marshal(),
+		)
+
+		val := crdt.NewEmbedTextValue(map[string]string{"image": "a.png"}, crdt.NewRHT())
+		assert.Equal(t, 1, val.Len())
+		assert.Panics(t, func() { val.Split(1) })
+	})
+
+	t.Run("offset of node test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		worldAt := ctx.IssueTimeTicket()
+		text.Edit(fromPos, toPos, nil, "World", nil, worldAt)
+		world := crdt.NewRGATreeSplitNodeID(worldAt, 0)
+
+		offset, err := text.OffsetOfNode(world)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, offset)
+
+		fromPos, toPos = text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello ", nil, ctx.IssueTimeTicket())
+		offset, err = text.OffsetOfNode(world)
+		assert.NoError(t, err)
+		assert.Equal(t, 6, offset)
+
+		// the ID of the middle of the node
+		offset, err = text.OffsetOfNode(crdt.NewRGATreeSplitNodeID(worldAt, 3))
+		assert.NoError(t, err)
+		assert.Equal(t, 9, offset)
+
+		fromPos, toPos = text.CreateRange(0, 6)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		offset, err = text.OffsetOfNode(crdt.NewRGATreeSplitNodeID(worldAt, 3))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, offset)
+
+		_, err = text.OffsetOfNode(crdt.NewRGATreeSplitNodeID(ctx.IssueTimeTicket(), 0))
+		assert.ErrorIs(t, err, crdt.ErrNodeNotFound)
+	})
+
+	t.Run("offset of purged node test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello")
+		root.RegisterElement(text)
+
+		fromPos, toPos := text.CreateRange(5, 5)
+		worldAt := ctx.IssueTimeTicket()
+		text.Edit(fromPos, toPos, nil, "World", nil, worldAt)
+		world := crdt.NewRGATreeSplitNodeID(worldAt, 3)
+		pos := crdt.NewRGATreeSplitNodePos(crdt.NewRGATreeSplitNodeID(worldAt, 0), 3)
+		_, err := text.CreatePos(pos.ID(), pos.RelativeOffset())
+		assert.NoError(t, err)
+
+		// the floor node of the purged node is the node of another insertion.
+		fromPos, toPos = text.CreateRange(5, 10)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		root.RegisterTextElementWithGarbage(text)
+		root.GarbageCollect(time.MaxTicket)
+		assert.Equal(t, `[{"val":"Hello"}]`, text.Marshal())
+
+		_, err = text.OffsetOfNode(world)
+		assert.ErrorIs(t, err, crdt.ErrNodeNotFound)
+		_, err = text.CreatePos(pos.ID(), pos.RelativeOffset())
+		assert.ErrorIs(t, err, crdt.ErrNodeNotFound)
+
+		// the position of the purged node doesn't snap to another insertion.
+		assert.Equal(t, 0, text.ResolvePos(pos))
+	})
+
+	t.Run("marshal with limit test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello World", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(5, 6)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(0, 1)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		json, truncated, err := text.MarshalWithLimit(3)
+		assert.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Equal(t, text.Marshal(), json)
+
+		json, truncated, err = text.MarshalWithLimit(2)
+		assert.ErrorIs(t, err, crdt.ErrNodeLimitExceeded)
+		assert.True(t, truncated)
+		assert.Equal(t, `[{"attrs":{"b":"1"},"val":"H"},{"val":"ello"}]`, json)
+	})
+
+	t.Run("line test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		assert.Equal(t, 1, text.LineCount())
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "package main\n\nfunc", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(18, 18)
+		text.Edit(fromPos, toPos, nil, " main() {\n", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(28, 28)
+		text.Edit(fromPos, toPos, nil, "}", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "package main\n\nfunc main() {\n}", text.String())
+
+		assert.Equal(t, 4, text.LineCount())
+		for i, expected := range []struct {
+			line   string
+			offset int
+		}{{"package main", 0}, {"", 13}, {"func main() {", 14}, {"}", 28}} {
+			line, err := text.LineAt(i)
+			assert.NoError(t, err)
+			assert.Equal(t, expected.line, line)
+
+			offset, err := text.OffsetOfLine(i)
+			assert.NoError(t, err)
+			assert.Equal(t, expected.offset, offset)
+		}
+
+		_, err := text.LineAt(4)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		_, err = text.OffsetOfLine(-1)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+	})
+
+	t.Run("len test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		root.RegisterElement(text)
+		assert.Equal(t, 0, text.Len())
+
+		walkLen := func() int {
+			length := 0
+			for _, node := range text.Nodes() {
+				length += node.Len()
+			}
+			return length
+		}
+
+		contents := []string{"Hello", "", "🌷", "World", "", "한글", "!"}
+		for i := 0; i < 100; i++ {
+			length := text.Len()
+			from := (i * 7) % (length + 1)
+			to := from + (i*3)%(length-from+1)
+			content := contents[i%len(contents)]
+
+			fromPos, toPos := text.CreateRange(from, to)
+			text.Edit(fromPos, toPos, nil, content, nil, ctx.IssueTimeTicket())
+			registerTextElementWithGarbage(fromPos, toPos, root, text)
+			assert.Equal(t, walkLen(), text.Len())
+
+			fromPos, toPos = text.CreateRange(0, text.Len()/2)
+			_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+			assert.NoError(t, err)
+			assert.Equal(t, walkLen(), text.Len())
+
+			if i%10 == 0 {
+				root.GarbageCollect(time.MaxTicket)
+				assert.Equal(t, walkLen(), text.Len())
+			}
+		}
+	})
+
+	t.Run("string as of test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		insertedAt := ctx.IssueTimeTicket()
+		text.Edit(fromPos, toPos, nil, "Hello World", nil, insertedAt)
+
+		fromPos, toPos = text.CreateRange(5, 11)
+		removedAt := ctx.IssueTimeTicket()
+		text.Edit(fromPos, toPos, nil, "", nil, removedAt)
+
+		fromPos, toPos = text.CreateRange(5, 5)
+		appendedAt := ctx.IssueTimeTicket()
+		text.Edit(fromPos, toPos, nil, "!", nil, appendedAt)
+
+		assert.Equal(t, "Hello!", text.String())
+		assert.Equal(t, "", text.StringAsOf(time.InitialTicket))
+		assert.Equal(t, "Hello World", text.StringAsOf(insertedAt))
+		assert.Equal(t, "Hello", text.StringAsOf(removedAt))
+		assert.Equal(t, "Hello!", text.StringAsOf(appendedAt))
+	})
+
+	t.Run("style all test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		// 01. styling an empty text is a no-op.
+		assert.NoError(t, text.StyleAll(map[string]string{"b": "1"}, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.ClearAllStyles(ctx.IssueTimeTicket()))
+		assert.Equal(t, `[]`, text.Marshal())
+
+		// 02. style the text with mixed styles.
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello World", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(0, 5)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"i": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(6, 11)
+		_, err = text.Style(fromPos, toPos, nil, map[string]string{"u": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		assert.NoError(t, text.StyleAll(map[string]string{"b": "1"}, ctx.IssueTimeTicket()))
+		assert.Equal(
+			t,
+			`[{"attrs":{"b":"1","i":"1"},"val":"Hello"},{"attrs":{"b":"1"},"val":" "},{"attrs":{"b":"1","u":"1"},"val":"World"}]`,
+			text.Marshal(),
+		)
+
+		assert.NoError(t, text.ClearAllStyles(ctx.IssueTimeTicket()))
+		assert.Equal(t, `[{"val":"Hello"},{"val":" "},{"val":"World"}]`, text.Marshal())
+	})
+
+	t.Run("merge test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		base := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		fromPos, toPos := base.CreateRange(0, 0)
+		base.Edit(fromPos, toPos, nil, "Hello World", nil, ctx.IssueTimeTicket())
+
+		newContext := func(hex string) *change.Context {
+			actorID, err := time.ActorIDFromHex(hex)
+			assert.NoError(t, err)
+			return change.NewContext(change.InitialID.SetActor(actorID).Next(), "", root)
+		}
+		ctxA := newContext("000000000000000000000001")
+		ctxB := newContext("000000000000000000000002")
+
+		// 01. edit the forks concurrently.
+		a := base.DeepCopy().(*crdt.Text)
+		fromPos, toPos = a.CreateRange(5, 5)
+		a.Edit(fromPos, toPos, nil, "X", nil, ctxA.IssueTimeTicket())
+		fromPos, toPos = a.CreateRange(0, 1)
+		a.Edit(fromPos, toPos, nil, "", nil, ctxA.IssueTimeTicket())
+		fromPos, toPos = a.CreateRange(0, 4)
+		_, err := a.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctxA.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, "elloX World", a.String())
+
+		b := base.DeepCopy().(*crdt.Text)
+		fromPos, toPos = b.CreateRange(5, 5)
+		b.Edit(fromPos, toPos, nil, "Y", nil, ctxB.IssueTimeTicket())
+		fromPos, toPos = b.CreateRange(7, 12)
+		b.Edit(fromPos, toPos, nil, "", nil, ctxB.IssueTimeTicket())
+		fromPos, toPos = b.CreateRange(0, 0)
+		b.Edit(fromPos, toPos, nil, "Z", nil, ctxB.IssueTimeTicket())
+		assert.Equal(t, "ZHelloY ", b.String())
+
+		// 02. merge the forks in both directions.
+		ab := a.DeepCopy().(*crdt.Text)
+		assert.NoError(t, ab.Merge(b))
+		ba := b.DeepCopy().(*crdt.Text)
+		assert.NoError(t, ba.Merge(a))
+
+		assert.Equal(t, "ZelloYX ", ab.String())
+		assert.Equal(t, ab.Marshal(), ba.Marshal())
+		assert.Equal(t, ab.Len(), ba.Len())
+		assert.True(t, ab.CheckWeight())
+		assert.True(t, ba.CheckWeight())
+
+		// 03. merging again is a no-op.
+		assert.NoError(t, ab.Merge(b))
+		assert.Equal(t, ba.Marshal(), ab.Marshal())
+
+		// 04. the texts not forked from the same text can't be merged.
+		other := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		assert.ErrorIs(t, ab.Merge(other), crdt.ErrDifferentText)
+	})
+
+	t.Run("author at test", func(t *testing.T) {
+		root := helper.TestRoot()
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+		ctxA := change.NewContext(change.InitialID.SetActor(actorA), "", root)
+		ctxB := change.NewContext(change.InitialID.SetActor(actorB).Next(), "", root)
+
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctxA.IssueTimeTicket())
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello", nil, ctxA.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(2, 2)
+		text.Edit(fromPos, toPos, nil, "ab", nil, ctxB.IssueTimeTicket())
+		assert.Equal(t, "Heabllo", text.String())
+
+		var authors []string
+		for i := 0; i < text.Len(); i++ {
+			author, err := text.AuthorAt(i)
+			assert.NoError(t, err)
+			authors = append(authors, author.String())
+		}
+		a, b := actorA.String(), actorB.String()
+		assert.Equal(t, []string{a, a, b, b, a, a, a}, authors)
+
+		_, err = text.AuthorAt(text.Len())
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		_, err = text.AuthorAt(-1)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+	})
+
+	t.Run("edit with nil latest created at map test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		_, latestCreatedAtMap, err := text.Edit(fromPos, toPos, nil, "Hello", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.NotNil(t, latestCreatedAtMap)
+		assert.Len(t, latestCreatedAtMap, 0)
+
+		fromPos, toPos = text.CreateRange(0, 2)
+		_, latestCreatedAtMap, err = text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, "llo", text.String())
+		assert.Len(t, latestCreatedAtMap, 1)
+	})
+
+	t.Run("rebalance test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		for i := 0; i < 100; i++ {
+			fromPos, toPos := text.CreateRange(text.Len(), text.Len())
+			text.Edit(fromPos, toPos, nil, "a", nil, ctx.IssueTimeTicket())
+		}
+		assert.Equal(t, crdt.TreeStats{Height: 101, NodeCount: 101}, text.TreeStats())
+
+		fromPos, toPos := text.CreateRange(10, 20)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		before := text.StructureAsString()
+
+		text.Rebalance()
+		assert.Equal(t, crdt.TreeStats{Height: 7, NodeCount: 101}, text.TreeStats())
+
+		assert.Equal(t, before, text.StructureAsString())
+		assert.Equal(t, 90, text.Len())
+		assert.True(t, text.CheckWeight())
+
+		fromPos, toPos = text.CreateRange(5, 85)
+		text.Edit(fromPos, toPos, nil, "b", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, strings.Repeat("a", 5)+"b"+strings.Repeat("a", 5), text.String())
+	})
+
+	t.Run("create range checked test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello")
+
+		fromPos, toPos, err := text.CreateRangeChecked(1, 3)
+		assert.NoError(t, err)
+		expectedFrom, expectedTo := text.CreateRange(1, 3)
+		assert.True(t, expectedFrom.Equal(fromPos))
+		assert.True(t, expectedTo.Equal(toPos))
+
+		_, _, err = text.CreateRangeChecked(3, 1)
+		assert.ErrorIs(t, err, crdt.ErrInvalidRange)
+		_, _, err = text.CreateRangeChecked(-1, 1)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		_, _, err = text.CreateRangeChecked(1, 6)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		assert.Equal(t, "Hello", text.String())
+	})
+
+	t.Run("structure as string test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+
+		fromPos, toPos := text.CreateRange(5, 6)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(0, 5)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"i": "1", "b": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(5, 5)
+		text.EditEmbed(fromPos, toPos, nil, map[string]string{"src": "a.png"}, nil, ctx.IssueTimeTicket())
+
+		assert.Equal(
+			t,
+			`[0:0:00:0 {} ""]`+
+				`[0:2:00:0 {"b":"1","i":"1"} "Hello"]`+
+				`[0:5:00:0 {} {"embed":{"src":"a.png"}}]`+
+				`{0:2:00:5 {} " "}`+
+				`[0:2:00:6 {} "World"]`,
+			text.StructureAsString(),
+		)
+		assert.Equal(t, text.StructureAsString(), text.DeepCopy().(*crdt.Text).StructureAsString())
+	})
+
+	t.Run("style if absent test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+
+		fromPos, toPos := text.CreateRange(0, 5)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"font": "serif"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		fromPos, toPos = text.CreateRange(3, 11)
+		text.StyleIfAbsent(fromPos, toPos, map[string]string{"font": "sans", "size": "12"}, ctx.IssueTimeTicket())
+		assert.Equal(
+			t,
+			`[{"attrs":{"font":"serif"},"val":"Hel"},`+
+				`{"attrs":{"font":"serif","size":"12"},"val":"lo"},`+
+				`{"attrs":{"font":"sans","size":"12"},"val":" World"}]`,
+			text.Marshal(),
+		)
+	})
+
+	t.Run("insert at and append test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		assert.NoError(t, text.Append("Hello", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append("🌷", map[string]string{"b": "1"}, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append("!", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, "Hello🌷!", text.String())
+		assert.Equal(t, 8, text.Len())
+
+		assert.NoError(t, text.InsertAt(5, " ", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t,
+			`[{"val":"Hello"},{"val":" "},{"attrs":{"b":"1"},"val":"🌷"},{"val":"!"}]`,
+			text.Marshal(),
+		)
+
+		err := text.InsertAt(10, "x", nil, ctx.IssueTimeTicket())
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		assert.Equal(t, "Hello 🌷!", text.String())
+	})
+
+	t.Run("purge result test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello 🌷 World")
+
+		fromPos, toPos := text.CreateRange(0, 5)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1", "i": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(3, 8)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "Hel World", text.String())
+
+		result := text.Purge(time.MaxTicket)
+		assert.Equal(t, crdt.PurgeResult{Nodes: 2, CodeUnits: 5, AttrEntries: 2}, result)
+		assert.Equal(t, crdt.PurgeResult{}, text.Purge(time.MaxTicket))
+	})
+
+	t.Run("concurrent edits with same lamport test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text1 := newTextWithContent(ctx, "ab")
+		text2 := text1.DeepCopy().(*crdt.Text)
+
+		actorA, _ := time.ActorIDFromHex("000000000000000000000001")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000002")
+		ticketA := time.NewTicket(10, 0, actorA)
+		ticketB := time.NewTicket(10, 0, actorB)
+
+		editAt := func(text *crdt.Text, content string, attrs map[string]string, ticket *time.Ticket) {
+			fromPos, toPos := text.CreateRange(1, 1)
+			text.Edit(fromPos, toPos, nil, content, attrs, ticket)
+		}
+
+		// NOTE: The edits are applied in the reverse order on each replica.
+		editAt(text1, "A", map[string]string{"c": "a"}, ticketA)
+		editAt(text1, "B", map[string]string{"c": "b"}, ticketB)
+		editAt(text2, "B", map[string]string{"c": "b"}, ticketB)
+		editAt(text2, "A", map[string]string{"c": "a"}, ticketA)
+		assert.Equal(t, "aBAb", text1.String())
+		assert.Equal(t, text1.Marshal(), text2.Marshal())
+
+		styleAll := func(text *crdt.Text, value string, ticket *time.Ticket) {
+			fromPos, toPos := text.CreateRange(0, text.Len())
+			_, err := text.Style(fromPos, toPos, nil, map[string]string{"c": value}, ticket)
+			assert.NoError(t, err)
+		}
+		ticketA = time.NewTicket(20, 0, actorA)
+		ticketB = time.NewTicket(20, 0, actorB)
+		styleAll(text1, "a", ticketA)
+		styleAll(text1, "b", ticketB)
+		styleAll(text2, "b", ticketB)
+		styleAll(text2, "a", ticketA)
+		assert.Equal(t, text1.Marshal(), text2.Marshal())
+		assert.Contains(t, text1.Marshal(), `"c":"b"`)
+		assert.NotContains(t, text1.Marshal(), `"c":"a"`)
+	})
+
+	t.Run("replace test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "abcdef")
+
+		fromPos, toPos := text.CreateRange(0, 2)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(2, 4)
+		_, err = text.Style(fromPos, toPos, nil, map[string]string{"i": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(4, 6)
+		_, err = text.Style(fromPos, toPos, nil, map[string]string{"u": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		// 01. The range with mixed styles inherits the style before it.
+		fromPos, toPos = text.CreateRange(1, 5)
+		text.Replace(fromPos, toPos, "X", ctx.IssueTimeTicket())
+		assert.Equal(
+			t,
+			`[{"attrs":{"b":"1"},"val":"a"},{"attrs":{"b":"1"},"val":"X"},{"attrs":{"u":"1"},"val":"f"}]`,
+			text.Marshal(),
+		)
+
+		// 02. The range at the beginning inherits the style of its first
+		// character.
+		fromPos, toPos = text.CreateRange(0, 1)
+		text.Replace(fromPos, toPos, "Y", ctx.IssueTimeTicket())
+		assert.Equal(
+			t,
+			`[{"attrs":{"b":"1"},"val":"Y"},{"attrs":{"b":"1"},"val":"X"},{"attrs":{"u":"1"},"val":"f"}]`,
+			text.Marshal(),
+		)
+
+		// 03. The range at the end inherits the style before it, not the
+		// removed one.
+		fromPos, toPos = text.CreateRange(2, 3)
+		text.Replace(fromPos, toPos, "Z", ctx.IssueTimeTicket())
+		assert.Equal(t, "YXZ", text.String())
+		assert.NotContains(t, text.Marshal(), `"u"`)
+
+		// 04. Replacing in an empty text inserts the content without style.
+		empty := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		fromPos, toPos = empty.CreateRange(0, 0)
+		empty.Replace(fromPos, toPos, "W", ctx.IssueTimeTicket())
+		assert.Equal(t, `[{"val":"W"}]`, empty.Marshal())
+	})
+
+	t.Run("create range by rune test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "a🌷b😜c")
+		assert.Equal(t, 7, text.Len())
+		assert.Equal(t, 5, text.RuneLen())
+
+		fromPos, toPos := text.CreateRangeByRune(1, 4)
+		text.Edit(fromPos, toPos, nil, "한", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "a한c", text.String())
+		assert.Equal(t, 3, text.Len())
+		assert.Equal(t, 3, text.RuneLen())
+
+		fromPos, toPos = text.CreateRangeByRune(3, 3)
+		text.Edit(fromPos, toPos, nil, "👍", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRangeByRune(4, 4)
+		text.Edit(fromPos, toPos, nil, "!", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "a한c👍!", text.String())
+		assert.Equal(t, 5, text.RuneLen())
+
+		fromPos, toPos = text.CreateRangeByRune(text.RuneLen(), text.RuneLen())
+		expectedFrom, expectedTo := text.CreateRange(text.Len(), text.Len())
+		assert.True(t, expectedFrom.Equal(fromPos))
+		assert.True(t, expectedTo.Equal(toPos))
+	})
+
+	t.Run("flatten test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+
+		fromPos, toPos := text.CreateRange(5, 5)
+		text.Edit(fromPos, toPos, nil, ",", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(0, 3)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(0, 3)
+		_, err = text.RemoveStyle(fromPos, toPos, nil, []string{"b"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(7, 9)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(7, 10)
+		_, err = text.Style(fromPos, toPos, nil, map[string]string{"i": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello, rld", text.String())
+
+		flattened := text.Flatten()
+		assert.Equal(t, text.String(), flattened.String())
+		assert.Equal(t, text.Len(), flattened.Len())
+		assert.Equal(t, `[{"val":"Hello, "},{"attrs":{"i":"1"},"val":"rld"}]`, flattened.Marshal())
+		assert.Less(t, len(flattened.Nodes()), len(text.Nodes()))
+
+		// NOTE: The flattened text is independent of the original one.
+		fromPos, toPos = flattened.CreateRange(0, 0)
+		flattened.Edit(fromPos, toPos, nil, ">", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, ">Hello, rld", flattened.String())
+		assert.Equal(t, "Hello, rld", text.String())
+		assert.Equal(t, `[{"val":">Hello, "},{"attrs":{"i":"1"},"val":"rld"}]`, flattened.Flatten().Marshal())
+	})
+
+	t.Run("content hash test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+		hash := text.ContentHash()
+
+		// 01. The edits that cancel out produce the original hash.
+		fromPos, toPos := text.CreateRange(5, 5)
+		text.Edit(fromPos, toPos, nil, " Yorkie", nil, ctx.IssueTimeTicket())
+		assert.NotEqual(t, hash, text.ContentHash())
+		fromPos, toPos = text.CreateRange(5, 12)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "Hello World", text.String())
+		assert.Equal(t, hash, text.ContentHash())
+
+		// 02. The texts of the same content split differently have the same hash.
+		other := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		assert.NoError(t, other.Append("Hel", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, other.Append("lo World", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, hash, other.ContentHash())
+
+		// 03. The attributes are also hashed.
+		fromPos, toPos = text.CreateRange(0, 5)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.NotEqual(t, hash, text.ContentHash())
+		fromPos, toPos = other.CreateRange(0, 5)
+		_, err = other.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, text.ContentHash(), other.ContentHash())
+		assert.Equal(t, text.ContentHash(), text.Flatten().ContentHash())
+	})
+
+	t.Run("persist position test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+
+		// 01. The position survives the round trip.
+		pos, _ := text.CreateRange(8, 8)
+		encoded := pos.Marshal()
+		decoded, err := crdt.ParseRGATreeSplitNodePos(encoded)
+		assert.NoError(t, err)
+		assert.True(t, pos.Equal(decoded))
+		assert.Equal(t, encoded, decoded.Marshal())
+		assert.Equal(t, 8, text.ResolvePos(decoded))
+
+		// 02. The position follows the content after the node is split.
+		fromPos, toPos := text.CreateRange(2, 2)
+		text.Edit(fromPos, toPos, nil, "XX", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "HeXXllo World", text.String())
+		assert.Equal(t, 10, text.ResolvePos(decoded))
+
+		// 03. The position snaps to the nearest live position after the
+		// content containing it is removed and purged.
+		fromPos, toPos = text.CreateRange(8, 11)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "HeXXllo ld", text.String())
+		assert.Equal(t, 8, text.ResolvePos(decoded))
+		text.Purge(time.MaxTicket)
+		assert.Equal(t, 8, text.ResolvePos(decoded))
+
+		for _, invalid := range []string{"", "1:2:3", "a:0:000000000000000000000000:0:0", "1:0:xyz:0:0", "1:0:000000000000000000000000:0:-1"} {
+			_, err := crdt.ParseRGATreeSplitNodePos(invalid)
+			assert.ErrorIs(t, err, crdt.ErrInvalidNodePos)
+		}
+	})
+
+	t.Run("blocks test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "TitleBody")
+		assert.Equal(t, []crdt.Block{{From: 0, To: 9}}, text.Blocks())
+
+		// 01. Inserting a separator splits the block.
+		assert.NoError(t, text.SplitBlock(5, map[string]string{"type": "h1"}, ctx.IssueTimeTicket()))
+		assert.Equal(t, []crdt.Block{
+			{From: 0, To: 5, Attributes: map[string]string{"type": "h1"}},
+			{From: 6, To: 10},
+		}, text.Blocks())
+
+		// 02. Inserting text inside a block keeps the blocks.
+		assert.NoError(t, text.InsertAt(5, "!", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.InsertAt(7, "The ", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, "Title!\nThe Body", text.String())
+		assert.Equal(t, []crdt.Block{
+			{From: 0, To: 6, Attributes: map[string]string{"type": "h1"}},
+			{From: 7, To: 15},
+		}, text.Blocks())
+
+		// 03. The block attributes are changed by styling the separator.
+		fromPos, toPos := text.CreateRange(11, 11)
+		text.Edit(fromPos, toPos, nil, "\n", map[string]string{"type": "p"}, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(6, 7)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"type": "h2"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, []crdt.Block{
+			{From: 0, To: 6, Attributes: map[string]string{"type": "h2"}},
+			{From: 7, To: 11, Attributes: map[string]string{"type": "p"}},
+			{From: 12, To: 16},
+		}, text.Blocks())
+
+		// 04. Removing a separator merges the blocks.
+		fromPos, toPos = text.CreateRange(6, 7)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "Title!The \nBody", text.String())
+		assert.Equal(t, []crdt.Block{
+			{From: 0, To: 10, Attributes: map[string]string{"type": "p"}},
+			{From: 11, To: 15},
+		}, text.Blocks())
+	})
+
+	t.Run("marshal by id test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text1 := newTextWithContent(ctx, "ab")
+		text2 := text1.DeepCopy().(*crdt.Text)
+
+		actorA, _ := time.ActorIDFromHex("000000000000000000000001")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000002")
+		apply := func(text *crdt.Text, reversed bool) {
+			insertFrom, insertTo := text.CreateRange(1, 1)
+			deleteFrom, deleteTo := text.CreateRange(0, 1)
+			insert := func() {
+				text.Edit(insertFrom, insertTo, nil, "X", nil, time.NewTicket(10, 0, actorA))
+			}
+			remove := func() {
+				text.Edit(deleteFrom, deleteTo, nil, "", nil, time.NewTicket(10, 0, actorB))
+			}
+			if reversed {
+				remove()
+				insert()
+			} else {
+				insert()
+				remove()
+			}
+		}
+
+		// NOTE: The concurrent edits are applied in the reverse order.
+		apply(text1, false)
+		apply(text2, true)
+		assert.Equal(t, "Xb", text1.String())
+		assert.Equal(t, text1.String(), text2.String())
+		assert.Equal(t, text1.MarshalByID(), text2.MarshalByID())
+
+		key := text1.Nodes()[0].ID().CreatedAt().Key()
+		assert.Equal(
+			t,
+			`[{"id":"`+key+`:0","insPrev":null,"removed":true,"value":{"val":"a"}},`+
+				`{"id":"`+key+`:1","insPrev":"`+key+`:0","removed":false,"value":{"val":"b"}},`+
+				`{"id":"10:0:000000000000000000000001:0","insPrev":null,"removed":false,"value":{"val":"X"}}]`,
+			text1.MarshalByID(),
+		)
+	})
+
+	t.Run("marshal with escape policy test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "<b>&</b>")
+
+		assert.Equal(t, `[{"val":"<b>&</b>"}]`, text.Marshal())
+		assert.Equal(t, text.Marshal(), text.MarshalWithPolicy(crdt.EscapeJSON))
+		assert.Equal(
+			t,
+			`[{"val":"\u003cb\u003e\u0026\u003c/b\u003e"}]`,
+			text.MarshalWithPolicy(crdt.EscapeHTMLSafe),
+		)
+	})
+
+	t.Run("marshal with defaults test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		assert.NoError(t, text.Append("Hello", map[string]string{"bold": "false", "italic": "false"}, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append(" World", map[string]string{"bold": "true", "italic": "false"}, ctx.IssueTimeTicket()))
+		pos, _ := text.CreateRange(11, 11)
+		text.EditEmbed(pos, pos, nil, map[string]string{"src": "a.png"}, map[string]string{"bold": "false"}, ctx.IssueTimeTicket())
+
+		// the attributes of the default values are omitted, and the others
+		// are preserved.
+		defaults := map[string]string{"bold": "false", "italic": "false"}
+		assert.Equal(
+			t,
+			`[{"val":"Hello"},{"attrs":{"bold":"true"},"val":" World"},{"embed":{"src":"a.png"}}]`,
+			text.MarshalWithDefaults(defaults),
+		)
+		assert.Equal(t, text.Marshal(), text.MarshalWithDefaults(nil))
+	})
+
+	t.Run("select by offset test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello")
+
+		assert.NoError(t, text.SelectByOffset(1, 3, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.SelectByOffset(5, 5, ctx.IssueTimeTicket()))
+		assert.ErrorIs(t, text.SelectByOffset(-1, 2, ctx.IssueTimeTicket()), crdt.ErrOutOfRange)
+		assert.ErrorIs(t, text.SelectByOffset(2, 6, ctx.IssueTimeTicket()), crdt.ErrOutOfRange)
+		assert.ErrorIs(t, text.SelectByOffset(3, 1, ctx.IssueTimeTicket()), crdt.ErrInvalidRange)
+	})
+
+	t.Run("visible nodes test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+		fromPos, toPos := text.CreateRange(5, 11)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, text.InsertAt(0, "Say ", nil, ctx.IssueTimeTicket()))
+
+		var contents []string
+		for _, node := range text.VisibleNodes() {
+			assert.Nil(t, node.RemovedAt())
+			contents = append(contents, node.String())
+		}
+		assert.Equal(t, []string{"Say ", "Hello"}, contents)
+		assert.Len(t, text.Nodes(), 3)
+	})
+
+	t.Run("total weight test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+		fromPos, toPos := text.CreateRange(5, 11)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, text.InsertAt(0, "Say ", nil, ctx.IssueTimeTicket()))
+
+		// 01. the removed nodes are counted until they are purged.
+		weight := 0
+		for _, node := range text.Nodes() {
+			weight += len(node.String())
+		}
+		assert.Equal(t, 9, text.Len())
+		assert.Equal(t, 15, weight)
+		assert.Equal(t, weight, text.TotalWeight())
+		assert.True(t, text.CheckWeight())
+
+		// 02. only the live nodes are counted after the purge.
+		text.Purge(time.MaxTicket)
+		assert.Equal(t, text.Len(), text.TotalWeight())
+		assert.True(t, text.CheckWeight())
+	})
+
+	t.Run("relative offset test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "abc\U0001F600")
+		assert.Equal(t, 5, text.Len())
+
+		// 01. -1 is the last code unit, and the whole surrogate pair is returned.
+		char, err := text.CharAt(-1)
+		assert.NoError(t, err)
+		assert.Equal(t, "\U0001F600", char)
+		char, err = text.CharAt(-5)
+		assert.NoError(t, err)
+		assert.Equal(t, "a", char)
+		_, err = text.CharAt(-6)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		_, err = text.CharAt(5)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+
+		// 02. the range is widened so as not to split a surrogate pair.
+		sub, err := text.Substring(-5, -2)
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", sub)
+		sub, err = text.Substring(1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, "bc\U0001F600", sub)
+		_, err = text.Substring(-6, 2)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		_, err = text.Substring(-1, -2)
+		assert.ErrorIs(t, err, crdt.ErrInvalidRange)
+
+		// 03. edit with the offsets counted from the end.
+		assert.NoError(t, text.EditByOffset(-2, -2, "d", nil, crdt.Policy{}, ctx.IssueTimeTicket()))
+		assert.Equal(t, "abcd\U0001F600", text.String())
+		assert.NoError(t, text.EditByOffset(-6, -5, "A", nil, crdt.Policy{}, ctx.IssueTimeTicket()))
+		assert.Equal(t, "Abcd\U0001F600", text.String())
+		assert.ErrorIs(t, text.EditByOffset(-7, 0, "", nil, crdt.Policy{}, ctx.IssueTimeTicket()), crdt.ErrOutOfRange)
+		assert.ErrorIs(t, text.EditByOffset(-1, -3, "", nil, crdt.Policy{}, ctx.IssueTimeTicket()), crdt.ErrInvalidRange)
+
+		// 04. the content over the limit of the policy is not inserted.
+		policy := crdt.Policy{MaxEditContentLen: 2}
+		assert.ErrorIs(t, text.EditByOffset(-2, -2, "xyz", nil, policy, ctx.IssueTimeTicket()), crdt.ErrContentTooLarge)
+		assert.Equal(t, "Abcd\U0001F600", text.String())
+		assert.NoError(t, text.EditByOffset(-2, -2, "xy", nil, policy, ctx.IssueTimeTicket()))
+		assert.Equal(t, "Abcdxy\U0001F600", text.String())
+	})
+
+	t.Run("for each reverse test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+		fromPos, toPos := text.CreateRange(5, 6)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, text.Append("!", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.InsertAt(0, "> ", nil, ctx.IssueTimeTicket()))
+
+		var reversed []*crdt.RGATreeSplitNode[*crdt.TextValue]
+		text.ForEachReverse(func(node *crdt.RGATreeSplitNode[*crdt.TextValue]) bool {
+			reversed = append(reversed, node)
+			return false
+		})
+		visible := text.VisibleNodes()
+		assert.Len(t, reversed, len(visible))
+		for i, node := range visible {
+			assert.Equal(t, node, reversed[len(reversed)-1-i])
+		}
+
+		// the iteration stops when the function returns true.
+		var last string
+		text.ForEachReverse(func(node *crdt.RGATreeSplitNode[*crdt.TextValue]) bool {
+			last = node.String()
+			return true
+		})
+		assert.Equal(t, "!", last)
+
+		empty := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		empty.ForEachReverse(func(node *crdt.RGATreeSplitNode[*crdt.TextValue]) bool {
+			assert.Fail(t, "empty text has no nodes")
+			return false
+		})
+	})
+
+	t.Run("links after random edits test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+		random := rand.New(rand.NewSource(1))
+
+		for i := 0; i < 300; i++ {
+			from := random.Intn(text.Len() + 1)
+			to := from + random.Intn(text.Len()-from+1)
+			content := strings.Repeat("x", random.Intn(3))
+			fromPos, toPos := text.CreateRange(from, to)
+			text.Edit(fromPos, toPos, nil, content, nil, ctx.IssueTimeTicket())
+
+			if i%50 == 49 {
+				text.Purge(ctx.IssueTimeTicket())
+			}
+		}
+		assert.True(t, text.CheckLinks())
+		assert.True(t, text.CheckWeight())
+
+		for _, node := range text.VisibleNodes() {
+			assert.Equal(t, node, node.Prev().Next())
+			if node.Next() != nil {
+				assert.Equal(t, node, node.Next().Prev())
+			}
+		}
+	})
+
+	t.Run("evict stale selections test", func(t *testing.T) {
+		actorA, _ := time.ActorIDFromHex("000000000000000000000001")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000002")
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), time.InitialTicket)
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello", nil, time.NewTicket(1, 0, actorA))
+
+		assert.NoError(t, text.SelectByOffset(0, 1, time.NewTicket(2, 0, actorA)))
+		assert.NoError(t, text.SelectByOffset(1, 2, time.NewTicket(5, 0, actorB)))
+
+		// the selection of actorA is stale, and the one of actorB is recent.
+		assert.Equal(t, 1, text.EvictStaleSelections(time.NewTicket(3, 0, actorA)))
+		assert.Equal(t, 0, text.EvictStaleSelections(time.NewTicket(3, 0, actorA)))
+		assert.Equal(t, 1, text.EvictStaleSelections(time.NewTicket(6, 0, actorA)))
+	})
+
+	t.Run("is empty test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		assert.True(t, text.IsEmpty())
+
+		assert.NoError(t, text.Append("Hello", nil, ctx.IssueTimeTicket()))
+		assert.False(t, text.IsEmpty())
+
+		// the text with only tombstones is empty.
+		fromPos, toPos := text.CreateRange(0, 5)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.Len(t, text.Nodes(), 1)
+		assert.True(t, text.IsEmpty())
+	})
+
+	t.Run("attribute keys test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+		assert.Equal(t, []string{}, text.AttributeKeys())
+
+		fromPos, toPos := text.CreateRange(0, 5)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"bold": "true", "color": "red"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(6, 11)
+		_, err = text.Style(fromPos, toPos, nil, map[string]string{"italic": "true", "color": "blue"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.NoError(t, text.Append("!", map[string]string{"link": "a"}, ctx.IssueTimeTicket()))
+		assert.Equal(t, []string{"bold", "color", "italic", "link"}, text.AttributeKeys())
+
+		// the keys of the removed nodes are not included.
+		fromPos, toPos = text.CreateRange(11, 12)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, []string{"bold", "color", "italic"}, text.AttributeKeys())
+	})
+
+	t.Run("marshal chunk test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+		for _, offset := range []int{9, 7, 5, 3, 1} {
+			fromPos, toPos := text.CreateRange(offset, offset+1)
+			_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+			assert.NoError(t, err)
+		}
+		fromPos, toPos := text.CreateRange(4, 6)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+
+		for _, maxBytes := range []int{0, 20, 64, 1 << 10} {
+			var values []string
+			var cursor *crdt.RGATreeSplitNodeID
+			for {
+				chunk, next, done, err := text.MarshalChunk(cursor, maxBytes)
+				assert.NoError(t, err)
+				assert.True(t, strings.HasPrefix(chunk, "[") && strings.HasSuffix(chunk, "]"))
+				if inner := chunk[1 : len(chunk)-1]; inner != "" {
+					values = append(values, inner)
+				}
+				cursor = next
+				if done {
+					break
+				}
+			}
+			assert.Equal(t, text.Marshal(), "["+strings.Join(values, ",")+"]", maxBytes)
+		}
+
+		chunk, next, done, err := text.MarshalChunk(nil, 1<<10)
+		assert.NoError(t, err)
+		assert.True(t, done)
+		assert.Equal(t, text.Marshal(), chunk)
+		chunk, _, done, err = text.MarshalChunk(next, 1<<10)
+		assert.NoError(t, err)
+		assert.True(t, done)
+		assert.Equal(t, "[]", chunk)
+
+		_, _, _, err = text.MarshalChunk(crdt.NewRGATreeSplitNodeID(ctx.IssueTimeTicket(), 0), 1<<10)
+		assert.ErrorIs(t, err, crdt.ErrNodeNotFound)
+	})
+
+	t.Run("snapshot as of test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+		fromPos, toPos := text.CreateRange(0, 5)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos = text.CreateRange(5, 11)
+		text.Edit(fromPos, toPos, nil, " Yorkie", nil, ctx.IssueTimeTicket())
+
+		// 01. the intermediate state is reconstructed.
+		intermediate := ctx.IssueTimeTicket()
+		expected := text.Flatten().Marshal()
+
+		fromPos, toPos = text.CreateRange(0, 2)
+		text.Edit(fromPos, toPos, nil, "J", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, text.Append("!", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, `[{"val":"J"},{"attrs":{"b":"1"},"val":"llo"},{"val":" Yorkie"},{"val":"!"}]`, text.Marshal())
+
+		snapshot := text.SnapshotAsOf(intermediate)
+		assert.Equal(t, expected, snapshot.Flatten().Marshal())
+		assert.Equal(t, text.StringAsOf(intermediate), snapshot.String())
+		assert.True(t, snapshot.CheckWeight())
+		assert.True(t, snapshot.CheckLinks())
+
+		// 02. the snapshot is independent of the text.
+		assert.NoError(t, snapshot.InsertAt(0, "> ", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, "> Hello Yorkie", snapshot.String())
+		assert.Equal(t, "Jllo Yorkie!", text.String())
+
+		// 03. the attributes set after the ticket are dropped.
+		fromPos, toPos = text.CreateRange(2, 3)
+		_, err = text.Style(fromPos, toPos, nil, map[string]string{"b": "2"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		snapshot = text.SnapshotAsOf(intermediate)
+		assert.Equal(
+			t,
+			`[{"attrs":{"b":"1"},"val":"Hel"},{"val":"l"},{"attrs":{"b":"1"},"val":"o"},{"val":" Yorkie"}]`,
+			snapshot.Flatten().Marshal(),
+		)
+	})
+
+	t.Run("style with invalid range test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Hello Yorkie", nil, ctx.IssueTimeTicket())
+
+		// 01. reversed positions are rejected without touching the nodes.
+		fromPos, _ = text.CreateRange(6, 6)
+		toPos, _ = text.CreateRange(2, 2)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.ErrorIs(t, err, crdt.ErrInvalidRange)
+		assert.Equal(t, `[{"val":"Hello Yorkie"}]`, text.Marshal())
+
+		// 02. positions of the purged nodes are rejected.
+		_, stalePos := text.CreateRange(0, 9)
+		fromPos, toPos = text.CreateRange(6, 12)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, 1, text.Purge(time.MaxTicket).Nodes)
+
+		fromPos, _ = text.CreateRange(0, 0)
+		_, err = text.Style(fromPos, stalePos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.ErrorIs(t, err, crdt.ErrNodeNotFound)
+		_, err = text.Style(stalePos, fromPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		assert.ErrorIs(t, err, crdt.ErrNodeNotFound)
+		assert.Equal(t, `[{"val":"Hello "}]`, text.Marshal())
+
+		// 03. the style of the other replicas with such positions is skipped
+		// instead of failing the change.
+		assert.NoError(t, text.ApplyStyle(fromPos, stalePos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket()))
+		toPos, _ = text.CreateRange(2, 2)
+		assert.NoError(t, text.ApplyStyle(toPos, fromPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket()))
+		assert.Equal(t, `[{"val":"Hello "}]`, text.Marshal())
+		_, toPos = text.CreateRange(0, 5)
+		assert.NoError(t, text.ApplyStyle(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket()))
+		assert.Equal(t, `[{"attrs":{"b":"1"},"val":"Hello"},{"val":" "}]`, text.Marshal())
+	})
+
+	t.Run("style with invalid attribute test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		assert.NoError(t, text.Append("Hello", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append(" Yorkie", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, `[{"val":"Hello"},{"val":" Yorkie"}]`, text.Marshal())
+
+		// 01. one invalid key among the valid ones is reported by its name.
+		fromPos, toPos := text.CreateRange(2, 9)
+		_, err := text.Style(fromPos, toPos, nil, map[string]string{
+			"bold":  "true",
+			"color": "\xff",
+			"size":  "12",
+		}, ctx.IssueTimeTicket())
+		assert.ErrorIs(t, err, crdt.ErrInvalidAttribute)
+		assert.Contains(t, err.Error(), `"color"`)
+
+		// 02. none of the keys is applied to any node.
+		for _, node := range text.Nodes() {
+			assert.Empty(t, node.Value().Attrs().Elements())
+		}
+		assert.Equal(t, `[{"val":"Hello"},{"val":" Yorkie"}]`, text.Marshal())
+
+		// 03. an empty key is also rejected.
+		_, err = text.Style(fromPos, toPos, nil, map[string]string{"": "1"}, ctx.IssueTimeTicket())
+		assert.ErrorIs(t, err, crdt.ErrInvalidAttribute)
+
+		_, err = text.Style(fromPos, toPos, nil, map[string]string{"bold": "true"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, `[{"val":"He"},{"attrs":{"bold":"true"},"val":"llo"},{"attrs":{"bold":"true"},"val":" Yor"},{"val":"kie"}]`, text.Marshal())
+	})
+
+	t.Run("remove empty nodes test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		rgaTreeSplit := crdt.NewRGATreeSplit(crdt.InitialTextNode())
+		text := crdt.NewText(rgaTreeSplit, ctx.IssueTimeTicket())
+
+		assert.NoError(t, text.Append("Hello", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append(" World", map[string]string{"b": "1"}, ctx.IssueTimeTicket()))
+		hello, world := text.Nodes()[0], text.Nodes()[1]
+
+		// 01. insert empty live nodes, one of them linked between the nodes.
+		empty := crdt.NewRGATreeSplitNode(
+			crdt.NewRGATreeSplitNodeID(ctx.IssueTimeTicket(), 0),
+			crdt.NewTextValue("", crdt.NewRHT()),
+		)
+		rgaTreeSplit.InsertAfter(hello, empty)
+		empty.SetInsPrev(hello)
+		world.SetInsPrev(empty)
+		rgaTreeSplit.InsertAfter(world, crdt.NewRGATreeSplitNode(
+			crdt.NewRGATreeSplitNodeID(ctx.IssueTimeTicket(), 0),
+			crdt.NewTextValue("", crdt.NewRHT()),
+		))
+		assert.Len(t, text.Nodes(), 4)
+		assert.Equal(t, "Hello World", text.String())
+
+		// 02. the empty nodes are removed without changing the content.
+		assert.Equal(t, 2, text.RemoveEmptyNodes())
+		assert.Len(t, text.Nodes(), 2)
+		assert.Equal(t, "Hello World", text.String())
+		assert.Equal(t, `[{"val":"Hello"},{"attrs":{"b":"1"},"val":" World"}]`, text.Marshal())
+		assert.Equal(t, hello.ID(), world.InsPrevID())
+		assert.True(t, text.CheckWeight())
+		assert.True(t, text.CheckLinks())
+		assert.Equal(t, 0, text.RemoveEmptyNodes())
+
+		// 03. the removed nodes are not counted as tombstones.
+		fromPos, toPos := text.CreateRange(0, 5)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, 0, text.RemoveEmptyNodes())
+		assert.Equal(t, " World", text.String())
+	})
+
+	t.Run("create pos test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := newTextWithContent(ctx, "Hello World")
+		id := text.Nodes()[0].ID()
+
+		// 01. The position created from the node ID survives the round trip.
+		pos, err := text.CreatePos(id, 8)
+		assert.NoError(t, err)
+		assert.Equal(t, 8, text.ResolvePos(pos))
+		decoded, err := crdt.ParseRGATreeSplitNodePos(pos.Marshal())
+		assert.NoError(t, err)
+		created, err := text.CreatePos(decoded.ID(), decoded.RelativeOffset())
+		assert.NoError(t, err)
+		assert.True(t, pos.Equal(created))
+
+		// 02. The offset may pass the boundary of the nodes split from it.
+		fromPos, toPos := text.CreateRange(2, 2)
+		text.Edit(fromPos, toPos, nil, "XX", nil, ctx.IssueTimeTicket())
+		pos, err = text.CreatePos(id, 11)
+		assert.NoError(t, err)
+		assert.Equal(t, 13, text.ResolvePos(pos))
+
+		// 03. The unknown nodes and the offsets beyond the content are rejected.
+		_, err = text.CreatePos(id, 12)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		_, err = text.CreatePos(id, -1)
+		assert.ErrorIs(t, err, crdt.ErrOutOfRange)
+		_, err = text.CreatePos(crdt.NewRGATreeSplitNodeID(ctx.IssueTimeTicket(), 0), 0)
+		assert.ErrorIs(t, err, crdt.ErrNodeNotFound)
+		_, err = text.CreatePos(crdt.NewRGATreeSplitNodeID(id.CreatedAt(), 1), 0)
+		assert.ErrorIs(t, err, crdt.ErrNodeNotFound)
+	})
+
+	t.Run("node exists test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		assert.NoError(t, text.Append("Hello", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append(" World", nil, ctx.IssueTimeTicket()))
+		hello, world := text.Nodes()[0].ID(), text.Nodes()[1].ID()
+
+		// 01. a live node.
+		exists, removed := text.NodeExists(hello)
+		assert.True(t, exists)
+		assert.False(t, removed)
+
+		// 02. a tombstoned node.
+		fromPos, toPos := text.CreateRange(5, 11)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		exists, removed = text.NodeExists(world)
+		assert.True(t, exists)
+		assert.True(t, removed)
+
+		// 03. a node purged by GC and an ID that has never been a node.
+		text.Purge(time.MaxTicket)
+		exists, removed = text.NodeExists(world)
+		assert.False(t, exists)
+		assert.False(t, removed)
+		exists, _ = text.NodeExists(crdt.NewRGATreeSplitNodeID(hello.CreatedAt(), 2))
+		assert.False(t, exists)
+		exists, _ = text.NodeExists(crdt.NewRGATreeSplitNodeID(ctx.IssueTimeTicket(), 0))
+		assert.False(t, exists)
+	})
+
+	t.Run("locked range test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		assert.NoError(t, text.Append("Dear NAME, hello", nil, ctx.IssueTimeTicket()))
+		fromPos, toPos := text.CreateRange(0, 5)
+		_, err := text.Style(fromPos, toPos, nil, crdt.LockAttributes(true), ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		for _, tc := range []struct {
+			desc     string
+			from, to int
+			locked   bool
+		}{
+			{"inside", 1, 3, true},
+			{"insert inside", 2, 2, true},
+			{"overlapping the end", 4, 7, true},
+			{"covering", 0, 16, true},
+			{"insert at the start", 0, 0, false},
+			{"insert at the end", 5, 5, false},
+			{"adjacent after", 5, 9, false},
+		} {
+			err := text.CheckLocked(tc.from, tc.to)
+			if tc.locked {
+				assert.ErrorIs(t, err, crdt.ErrLockedRange, tc.desc)
+			} else {
+				assert.NoError(t, err, tc.desc)
+			}
+		}
+
+		// the lock is not inherited by the content inserted next to it.
+		attrs := text.InheritedAttributes(5, 5, crdt.InheritLeft)
+		assert.NotContains(t, attrs, crdt.LockAttribute)
+
+		// unlocking overrides the lock by the last writer.
+		_, err = text.Style(fromPos, toPos, nil, crdt.LockAttributes(false), ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.NoError(t, text.CheckLocked(1, 3))
+	})
+
+	t.Run("get range text test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		assert.NoError(t, text.Append("Hello", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append(" 🌍 ", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append("World", nil, ctx.IssueTimeTicket()))
+		fromPos, toPos := text.CreateRange(2, 4)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.Equal(t, "Heo 🌍 World", text.String())
+
+		for _, tc := range []struct {
+			from, to int
+			expected string
+		}{
+			{0, 12, "Heo 🌍 World"},
+			{1, 9, "eo 🌍 Wo"},
+			{2, 7, "o 🌍 "},
+			{8, 11, "orl"},
+			{3, 3, ""},
+		} {
+			fromPos, toPos := text.CreateRange(tc.from, tc.to)
+			content, err := text.GetRangeText(fromPos, toPos)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, content)
+
+			expected, err := text.Substring(tc.from, tc.to)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, content)
+		}
+
+		fromPos, toPos = text.CreateRange(5, 2)
+		_, err := text.GetRangeText(fromPos, toPos)
+		assert.ErrorIs(t, err, crdt.ErrInvalidRange)
+	})
+
+	t.Run("split at test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		assert.NoError(t, text.Append("Hello World", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append("!", nil, ctx.IssueTimeTicket()))
+
+		// 01. split a node in the middle.
+		pos, _ := text.CreateRange(5, 5)
+		right, err := text.SplitAt(pos, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, " World", right.String())
+		assert.Equal(t, "Hello World!", text.String())
+		assert.Len(t, text.Nodes(), 3)
+		assert.True(t, text.CheckWeight())
+
+		// 02. split at the boundary of the nodes doesn't split any node.
+		pos, _ = text.CreateRange(11, 11)
+		right, err = text.SplitAt(pos, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, "!", right.String())
+		assert.Len(t, text.Nodes(), 3)
+
+		pos, _ = text.CreateRange(12, 12)
+		right, err = text.SplitAt(pos, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Nil(t, right)
+
+		// 03. the position of a removed node can't be split.
+		fromPos, toPos := text.CreateRange(0, 5)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		_, err = text.SplitAt(crdt.NewRGATreeSplitNodePos(text.Nodes()[0].ID(), 2), ctx.IssueTimeTicket())
+		assert.ErrorIs(t, err, crdt.ErrRemovedNode)
+		assert.Equal(t, " World!", text.String())
+		assert.True(t, text.CheckWeight())
+	})
+
+	t.Run("coalesce insert test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		text.BeginTyping(time.InitialActorID)
+
+		// 01. the uninterrupted keystrokes are coalesced into the first node.
+		pos, _ := text.CreateRange(0, 0)
+		ticket := ctx.IssueTimeTicket()
+		text.Edit(pos, pos, nil, "a", nil, ticket)
+		for i, char := range []string{"b", "c"} {
+			pos, _ = text.CreateRange(i+1, i+1)
+			_, ok := text.CoalesceInsert(pos, char, nil, ticket)
+			assert.True(t, ok)
+		}
+		assert.Equal(t, "abc", text.String())
+		assert.Len(t, text.Nodes(), 1)
+		assert.True(t, text.CheckWeight())
+
+		// 02. the keystroke not adjacent to the last one isn't coalesced.
+		pos, _ = text.CreateRange(1, 1)
+		_, ok := text.CoalesceInsert(pos, "x", nil, ticket)
+		assert.False(t, ok)
+
+		// 03. the keystroke after a remote edit isn't coalesced.
+		actorID, _ := time.ActorIDFromHex("000000000000000000000002")
+		remote := ctx.IssueTimeTicket().SetActorID(actorID)
+		pos, _ = text.CreateRange(0, 0)
+		text.Edit(pos, pos, nil, "R", nil, remote)
+		pos, _ = text.CreateRange(4, 4)
+		_, ok = text.CoalesceInsert(pos, "d", nil, ticket)
+		assert.False(t, ok)
+		assert.Equal(t, "Rabc", text.String())
+		assert.Len(t, text.Nodes(), 2)
+
+		// 04. nothing is coalesced after the session ends.
+		pos, _ = text.CreateRange(4, 4)
+		ticket = ctx.IssueTimeTicket()
+		text.Edit(pos, pos, nil, "d", nil, ticket)
+		text.EndTyping()
+		pos, _ = text.CreateRange(5, 5)
+		_, ok = text.CoalesceInsert(pos, "e", nil, ticket)
+		assert.False(t, ok)
+
+		// 05. nothing is coalesced into the node discarded by a rollback.
+		text.BeginTyping(time.InitialActorID)
+		ticket = ctx.IssueTimeTicket()
+		_, err := text.Transaction(func(tx *crdt.TextTx) error {
+			assert.NoError(t, tx.Edit(5, 5, "e", nil, ticket))
+			return errors.New("rollback")
+		})
+		assert.Error(t, err)
+		pos = crdt.NewRGATreeSplitNodePos(crdt.NewRGATreeSplitNodeID(ticket, 0), 1)
+		_, ok = text.CoalesceInsert(pos, "f", nil, ticket)
+		assert.False(t, ok)
+		assert.Equal(t, "Rabcd", text.String())
+		assert.True(t, text.CheckWeight())
+	})
+
+	t.Run("append with tail test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		rgaTreeSplit := crdt.NewRGATreeSplit(crdt.InitialTextNode())
+		text := crdt.NewText(rgaTreeSplit, ctx.IssueTimeTicket())
+
+		assert.NoError(t, text.Append("a", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append("b", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, "ab", text.String())
+
+		// 01. a node inserted after the tail by another actor.
+		rgaTreeSplit.InsertAfter(text.Nodes()[1], crdt.NewRGATreeSplitNode(
+			crdt.NewRGATreeSplitNodeID(ctx.IssueTimeTicket(), 0),
+			crdt.NewTextValue("c", crdt.NewRHT()),
+		))
+		assert.NoError(t, text.Append("d", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, "abcd", text.String())
+
+		// 02. the tail removed or split by edits.
+		fromPos, toPos := text.CreateRange(3, 4)
+		text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, text.Append("e", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, "abce", text.String())
+
+		assert.NoError(t, text.Append("fg", nil, ctx.IssueTimeTicket()))
+		fromPos, toPos = text.CreateRange(5, 5)
+		text.Edit(fromPos, toPos, nil, "X", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, text.Append("h", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, "abcefXgh", text.String())
+		assert.True(t, text.CheckWeight())
+		assert.True(t, text.CheckLinks())
+	})
+
+	t.Run("append after remote insert test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		assert.NoError(t, text.Append("ab", nil, ctx.IssueTimeTicket()))
+
+		// 01. a remote edit inserts a node after the cached tail.
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+		fromPos, toPos := text.CreateRange(2, 2)
+		_, _, err = text.Edit(fromPos, toPos, nil, "X", nil, time.NewTicket(10, 0, actorB))
+		assert.NoError(t, err)
+		assert.Equal(t, "abX", text.String())
+
+		// 02. the next append goes after the remote node, not after the tail.
+		assert.NoError(t, text.Append("c", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, "abXc", text.String())
+		nodes := text.Nodes()
+		assert.Equal(t, "c", nodes[len(nodes)-1].String())
+		assert.True(t, text.CheckWeight())
+		assert.True(t, text.CheckLinks())
+	})
+
+	t.Run("trailing whitespace ranges test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		// the whitespace of a line spans multiple nodes.
+		assert.NoError(t, text.Append("a \t", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append("  \nb", map[string]string{"b": "1"}, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append(" c\t\n\n \n d ", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, [][2]int{{1, 5}, {9, 10}, {12, 13}, {16, 17}}, text.TrailingWhitespaceRanges())
+
+	})
+
+	t.Run("index of test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		// the occurrences span multiple nodes.
+		assert.NoError(t, text.Append("foo ba", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append("r foo", map[string]string{"b": "1"}, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append("bar", nil, ctx.IssueTimeTicket()))
+		assert.Equal(t, 4, text.IndexOf("bar", 0))
+		assert.Equal(t, 11, text.IndexOf("bar", 5))
+		assert.Equal(t, -1, text.IndexOf("baz", 0))
+		assert.Equal(t, []int{4, 11}, text.IndexesOf("bar"))
+	})
+
+	t.Run("node count and avg node length test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		live, removed := text.NodeCount()
+		assert.Equal(t, 0, live)
+		assert.Equal(t, 0, removed)
+		assert.Equal(t, float64(0), text.AvgNodeLength())
+
+		// 01. a freshly built text has a few long nodes.
+		assert.NoError(t, text.Append("Hello World", nil, ctx.IssueTimeTicket()))
+		assert.NoError(t, text.Append(", Yorkie", nil, ctx.IssueTimeTicket()))
+		live, removed = text.NodeCount()
+		assert.Equal(t, 2, live)
+		assert.Equal(t, 0, removed)
+		assert.Equal(t, 9.5, text.AvgNodeLength())
+
+		// 02. a heavily edited text has many short nodes and tombstones.
+		for i := 0; i < 8; i++ {
+			fromPos, toPos := text.CreateRange(i*2, i*2+1)
+			text.Edit(fromPos, toPos, nil, "x", nil, ctx.IssueTimeTicket())
+		}
+		assert.Equal(t, 19, text.Len())
+		live, removed = text.NodeCount()
+		assert.Equal(t, 16, live)
+		assert.Equal(t, 8, removed)
+		assert.Equal(t, 19.0/16.0, text.AvgNodeLength())
+
+		// 03. the purge reclaims the tombstones.
+		text.Purge(time.MaxTicket)
+		live, removed = text.NodeCount()
+		assert.Equal(t, 16, live)
+		assert.Equal(t, 0, removed)
+	})
+
+	t.Run("concurrent overlapping removes test", func(t *testing.T) {
+		actorA, _ := time.ActorIDFromHex("000000000000000000000001")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000002")
+		actorC, _ := time.ActorIDFromHex("000000000000000000000003")
+
+		base := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), time.NewTicket(1, 0, actorC))
+		fromPos, toPos := base.CreateRange(0, 0)
+		base.Edit(fromPos, toPos, nil, "0123456789", nil, time.NewTicket(2, 0, actorC))
+		textA, textB := base.DeepCopy().(*crdt.Text), base.DeepCopy().(*crdt.Text)
+
+		// 01. A removes 2..6 and then inserts, while B removes 4..8.
+		fromA, toA := textA.CreateRange(2, 6)
+		_, mapA, err := textA.Edit(fromA, toA, nil, "", nil, time.NewTicket(3, 0, actorA))
+		assert.NoError(t, err)
+		fromNew, toNew := textA.CreateRange(2, 2)
+		_, mapNew, err := textA.Edit(fromNew, toNew, nil, "new", nil, time.NewTicket(4, 0, actorA))
+		assert.NoError(t, err)
+		fromB, toB := textB.CreateRange(4, 8)
+		_, mapB, err := textB.Edit(fromB, toB, nil, "", nil, time.NewTicket(5, 0, actorB))
+		assert.NoError(t, err)
+
+		// 02. the edits are applied in the different orders.
+		textA.Edit(fromB, toB, mapB, "", nil, time.NewTicket(5, 0, actorB))
+		textB.Edit(fromA, toA, mapA, "", nil, time.NewTicket(3, 0, actorA))
+		textB.Edit(fromNew, toNew, mapNew, "new", nil, time.NewTicket(4, 0, actorA))
+
+		// 03. the deletions are united without removing the concurrent insert.
+		assert.Equal(t, "01new89", textA.String())
+		assert.Equal(t, textA.String(), textB.String())
+		assert.True(t, textA.CheckWeight())
+		assert.True(t, textB.CheckWeight())
+
+		// 04. the tombstones keep the earliest removals in both replicas.
+		nodesA, nodesB := textA.Nodes(), textB.Nodes()
+		assert.Equal(t, len(nodesA), len(nodesB))
+		for i := range nodesA {
+			assert.Equal(t, nodesA[i].ID().StructureAsString(), nodesB[i].ID().StructureAsString())
+			assert.Equal(t, nodesA[i].RemovedAt(), nodesB[i].RemovedAt())
+			if nodesA[i].String() == "45" {
+				assert.Equal(t, int64(3), nodesA[i].RemovedAt().Lamport())
+			}
+		}
+	})
+}
+
+func FuzzMarshal(f *testing.F) {
+	f.Add("Hello World", "bold", "true", 1.5, []byte("bytes"))
+	f.Add("\"quoted\" \\ back", "k\"ey", "va\\l\"ue", math.Inf(1), []byte{0x00, 0x1f, '"'})
+	f.Add("\x00\x01\b\f\n\r\t\x7f", "\u2028", "\u2029", math.NaN(), []byte{0xff, 0xfe})
+	f.Add("\xed\xa0\x80\xc3", "<a>", "&amp;", -0.0, []byte("\\u0000"))
+
+	f.Fuzz(func(t *testing.T, content, key, value string, num float64, bytes []byte) {
+		assertValid := func(marshaled string) {
+			if !json.Valid([]byte(marshaled)) || !utf8.ValidString(marshaled) {
+				t.Fatalf("invalid JSON: %q", marshaled)
+			}
+		}
+
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		// 01. the text with the attributes and the embedded object.
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, content, map[string]string{key: value}, ctx.IssueTimeTicket())
+		fromPos, toPos = text.CreateRange(0, 0)
+		text.EditEmbed(fromPos, toPos, nil, map[string]string{key: value}, nil, ctx.IssueTimeTicket())
+		assertValid(text.Marshal())
+		assertValid(text.MarshalWithPolicy(crdt.EscapeHTMLSafe))
+
+		// 02. the attributes of the typed values.
+		attrs := crdt.NewRHT()
+		attrs.Set(key, value, ctx.IssueTimeTicket())
+		assert.NoError(t, attrs.SetValue("bool", num > 0, ctx.IssueTimeTicket()))
+		assert.NoError(t, attrs.SetValue("long", int64(num), ctx.IssueTimeTicket()))
+		if err := attrs.SetValue("num", num, ctx.IssueTimeTicket()); math.IsNaN(num) || math.IsInf(num, 0) {
+			assert.ErrorIs(t, err, crdt.ErrInvalidAttribute)
+		} else {
+			assert.NoError(t, err)
+		}
+		assertValid(attrs.Marshal())
+		assertValid(crdt.NewTextValue(content, attrs).Marshal())
+
+		// 03. the object with the primitives of every type.
+		obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		obj.Set(key, crdt.NewPrimitive(value, ctx.IssueTimeTicket()))
+		obj.Set(content, crdt.NewPrimitive(bytes, ctx.IssueTimeTicket()))
+		obj.Set("num", crdt.NewPrimitive(num, ctx.IssueTimeTicket()))
+		obj.Set("cnt", crdt.NewCounter(crdt.DoubleCnt, num, ctx.IssueTimeTicket()))
+		obj.Set("text", text)
+		assertValid(obj.Marshal())
+	})
 }
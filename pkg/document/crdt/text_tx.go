@@ -0,0 +1,121 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"unicode/utf16"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// TextTx is a transaction of Text. The edits and styles in a transaction are
+// applied to the Text immediately, but their changes are notified at once
+// when the transaction is committed.
+type TextTx struct {
+	text    *Text
+	changes []TextChange
+}
+
+// Edit edits the given range of integer offsets with the given content and
+// attributes. It returns an error if the range is invalid.
+func (tx *TextTx) Edit(
+	from,
+	to int,
+	content string,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) error {
+	fromPos, toPos, err := tx.text.CreateRangeChecked(from, to)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = tx.text.Edit(fromPos, toPos, nil, content, attributes, executedAt)
+	return err
+}
+
+// Style applies the given attributes to the given range of integer offsets.
+// It returns an error if the range is invalid.
+func (tx *TextTx) Style(
+	from,
+	to int,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) error {
+	fromPos, toPos, err := tx.text.CreateRangeChecked(from, to)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.text.Style(fromPos, toPos, nil, attributes, executedAt)
+	return err
+}
+
+// Transaction runs the given function with a transaction of this Text, so
+// that the edits and styles of a single gesture are notified as a single set
+// of changes. If the function returns an error, all the edits and styles
+// already applied in the transaction are reverted and nothing is notified.
+// Otherwise, the coalesced changes are notified to the function registered by
+// OnChange and the channels returned by Watch, and returned.
+//
+// The Text is copied at the beginning of the transaction to revert it, so the
+// cost of a transaction is proportional to the number of the nodes.
+func (t *Text) Transaction(fn func(tx *TextTx) error) ([]TextChange, error) {
+	backup := t.DeepCopy().(*Text).rgaTreeSplit
+	onChange, watchers := t.onChange, t.watchers
+
+	tx := &TextTx{text: t}
+	t.onChange = func(change TextChange) {
+		tx.changes = append(tx.changes, change)
+	}
+	t.watchers = nil
+	err := fn(tx)
+	t.onChange, t.watchers = onChange, watchers
+
+	if err != nil {
+		t.rgaTreeSplit = backup
+		t.tail = nil
+		t.abandonTyping()
+		return nil, err
+	}
+
+	changes := coalesceChanges(tx.changes)
+	for _, change := range changes {
+		t.emit(change)
+	}
+
+	return changes, nil
+}
+
+// coalesceChanges merges the consecutive insertions that continue each other
+// with the same attributes, such as the characters typed one by one.
+func coalesceChanges(changes []TextChange) []TextChange {
+	var coalesced []TextChange
+	for _, change := range changes {
+		last := len(coalesced) - 1
+		if last >= 0 && change.Type == TextInsertChange && coalesced[last].Type == TextInsertChange &&
+			change.From == coalesced[last].From+len(utf16.Encode([]rune(coalesced[last].Content))) &&
+			equalAttrs(change.Attributes, coalesced[last].Attributes) {
+			coalesced[last].Content += change.Content
+			continue
+		}
+
+		coalesced = append(coalesced, change)
+	}
+
+	return coalesced
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"unicode/utf8"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// typingSession is the state of the typing of an actor, which coalesces the
+// consecutive keystrokes into the node of the first one.
+type typingSession struct {
+	// actorID is the actor typing in this session.
+	actorID *time.ActorID
+
+	// node is the node inserted by the last keystroke of the actor. It is
+	// nil if the last edit of this Text was not a keystroke of the actor,
+	// such as a remote edit, so the next keystroke is not coalesced.
+	node *RGATreeSplitNode[*TextValue]
+}
+
+// BeginTyping begins the typing session of the given actor, in which the
+// consecutive single-character inserts of the actor at the adjacent
+// positions can be coalesced into one node by CoalesceInsert.
+func (t *Text) BeginTyping(actorID *time.ActorID) {
+	t.typing = &typingSession{actorID: actorID}
+}
+
+// EndTyping ends the typing session.
+func (t *Text) EndTyping() {
+	t.typing = nil
+}
+
+// CoalesceInsert inserts the given content into the given position by
+// appending it to the node of the last keystroke of the typing session
+// instead of inserting a new node, and returns the position after it. It
+// only coalesces a single character with the same attributes as the node
+// right after the node created at the given time, so that the caller can
+// append the content to the edit operation of the node instead of making a
+// new one. It returns false without inserting if the content can't be
+// coalesced, for example because another edit has been made since the last
+// keystroke.
+func (t *Text) CoalesceInsert(
+	pos *RGATreeSplitNodePos,
+	content string,
+	attributes map[string]string,
+	createdAt *time.Ticket,
+) (*RGATreeSplitNodePos, bool) {
+	if t.typing == nil || t.typing.node == nil || utf8.RuneCountInString(content) != 1 {
+		return nil, false
+	}
+
+	// NOTE: The node should be the last part of the content of the edit, so
+	// that the appended content is at the end of the content.
+	node := t.typing.node
+	if node.removedAt != nil || node.insNext != nil || node.value.IsEmbed() ||
+		node.createdAt().Compare(createdAt) != 0 ||
+		!equalAttrs(node.value.attrs.Elements(), attributes) {
+		return nil, false
+	}
+
+	id := pos.getAbsoluteID()
+	if !id.hasSameCreatedAt(node.id) || id.offset != node.id.offset+node.contentLen() {
+		return nil, false
+	}
+
+	fromIdx, _ := t.offsetsOf(pos, pos)
+	node.value.value += content
+	t.rgaTreeSplit.treeByIndex.Splay(node.indexNode)
+	t.rgaTreeSplit.treeByIndex.UpdateWeight(node.indexNode)
+	t.notifyEdit(fromIdx, fromIdx, content, attributes)
+
+	return NewRGATreeSplitNodePos(node.id, node.contentLen()), true
+}
+
+// trackTyping records the node inserted by the given edit if it is a
+// keystroke of the actor of the typing session, or abandons the coalescing
+// of the next keystroke otherwise.
+func (t *Text) trackTyping(
+	from,
+	to *RGATreeSplitNodePos,
+	content string,
+	executedAt *time.Ticket,
+	cursorPos *RGATreeSplitNodePos,
+) {
+	if t.typing == nil {
+		return
+	}
+
+	t.typing.node = nil
+	if from.Equal(to) && utf8.RuneCountInString(content) == 1 &&
+		executedAt.ActorID().Compare(t.typing.actorID) == 0 {
+		t.typing.node = t.rgaTreeSplit.FindNode(cursorPos.id)
+	}
+}
+
+// abandonTyping abandons the coalescing of the next keystroke, because the
+// text has been changed other than by a keystroke.
+func (t *Text) abandonTyping() {
+	if t.typing != nil {
+		t.typing.node = nil
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Version is a version vector of a document. It keeps the largest Lamport
+// timestamp of the operations applied to the document for each actor, so it
+// advances whenever an operation is applied. Two versions of the replicas
+// that have applied concurrent operations don't include each other.
+type Version map[string]int64
+
+// Includes returns whether this version includes the given version, that
+// is, whether all the operations reflected in the given version have also
+// been reflected in this version.
+func (v Version) Includes(other Version) bool {
+	for actor, lamport := range other {
+		if v[actor] < lamport {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepCopy copies itself deeply.
+func (v Version) DeepCopy() Version {
+	version := make(Version, len(v))
+	for actor, lamport := range v {
+		version[actor] = lamport
+	}
+	return version
+}
+
+// advance advances this version with the given execution time of the
+// operation.
+func (v Version) advance(executedAt *time.Ticket) {
+	actor := executedAt.ActorIDHex()
+	if lamport, ok := v[actor]; !ok || lamport < executedAt.Lamport() {
+		v[actor] = executedAt.Lamport()
+	}
+}
+
+// ComputeGCSafePoint returns the ticket below which the removed elements and
+// nodes can be purged by Root.GarbageCollect without breaking the
+// convergence of the given clients, where each version is what a connected
+// client has acknowledged.
+//
+// A client that has seen the operations of an actor up to a Lamport
+// timestamp has seen all of them before it, so a removal is safe to purge if
+// every client has seen it. The safe point is therefore the smallest Lamport
+// timestamp that each client has seen from every actor known to any client,
+// and a lagging client holds it back. If there are no clients, everything is
+// safe to purge.
+func ComputeGCSafePoint(clientVersions []Version) *time.Ticket {
+	if len(clientVersions) == 0 {
+		return time.MaxTicket
+	}
+
+	actors := make(map[string]bool)
+	for _, version := range clientVersions {
+		for actor := range version {
+			actors[actor] = true
+		}
+	}
+
+	var safePoint int64 = time.MaxLamport
+	for _, version := range clientVersions {
+		for actor := range actors {
+			if lamport := version[actor]; lamport < safePoint {
+				safePoint = lamport
+			}
+		}
+	}
+
+	return time.NewTicket(safePoint, time.MaxDelimiter, time.MaxActorID)
+}
@@ -18,6 +18,7 @@
 package document
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
@@ -71,7 +72,9 @@ func (d *Document) Update(
 
 	if ctx.HasOperations() {
 		c := ctx.ToChange()
-		if err := c.Execute(d.doc.root); err != nil {
+		// NOTE: Local changes are applied synchronously, so they are not
+		// cancelled.
+		if err := c.Execute(context.Background(), d.doc.root); err != nil {
 			return err
 		}
 
@@ -82,8 +85,24 @@ func (d *Document) Update(
 	return nil
 }
 
-// ApplyChangePack applies the given change pack into this document.
-func (d *Document) ApplyChangePack(pack *change.Pack) error {
+// ApplyPatch applies the given JSON Patch(RFC 6902) to this document as a
+// single change. The patch is applied atomically, so if an operation of the
+// patch fails, none of them is applied.
+func (d *Document) ApplyPatch(patch []json.PatchOp, msgAndArgs ...interface{}) error {
+	return d.Update(func(root *json.Object) error {
+		return root.ApplyPatch(patch)
+	}, msgAndArgs...)
+}
+
+// ApplyChangePack applies the given change pack into this document. The
+// given context is checked between the changes while they are applied to the
+// clone. If it is done, the error of the context is returned and the
+// document is left untouched.
+func (d *Document) ApplyChangePack(ctx context.Context, pack *change.Pack) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// 01. Apply remote changes to both the clone and the document.
 	if len(pack.Snapshot) > 0 {
 		d.clone = nil
@@ -92,14 +111,22 @@ func (d *Document) ApplyChangePack(pack *change.Pack) error {
 		}
 	} else {
 		d.ensureClone()
+		changes := d.doc.unreceivedChanges(pack.Changes)
+		if err := change.ValidateChanges(d.clone, changes); err != nil {
+			return err
+		}
 
-		for _, c := range pack.Changes {
-			if err := c.Execute(d.clone); err != nil {
+		for _, c := range changes {
+			if err := c.Execute(ctx, d.clone); err != nil {
+				// drop clone because it is applied partially.
+				d.clone = nil
 				return err
 			}
 		}
 
-		if err := d.doc.ApplyChanges(pack.Changes...); err != nil {
+		// NOTE: The changes have been applied to the clone, so they are
+		// applied to the document without being cancelled.
+		if err := d.doc.applyChanges(uncancelableContext{ctx}, changes); err != nil {
 			return err
 		}
 	}
@@ -158,6 +185,25 @@ func (d *Document) SetActor(actor *time.ActorID) {
 	d.doc.SetActor(actor)
 }
 
+// SetPolicy sets the policy that limits the remote changes applied to this
+// document.
+func (d *Document) SetPolicy(policy crdt.Policy) {
+	d.doc.SetPolicy(policy)
+	if d.clone != nil {
+		d.clone.SetPolicy(policy)
+	}
+}
+
+// SetTicketGenerator sets the generator that issues the tickets of the local
+// operations of this document, e.g. to make the tickets deterministic in
+// tests.
+func (d *Document) SetTicketGenerator(generator time.TicketGenerator) {
+	d.doc.SetTicketGenerator(generator)
+	if d.clone != nil {
+		d.clone.SetTicketGenerator(generator)
+	}
+}
+
 // ActorID returns ID of the actor currently editing the document.
 func (d *Document) ActorID() *time.ActorID {
 	return d.doc.ActorID()
@@ -17,16 +17,22 @@
 package document_test
 
 import (
+	"context"
+	gojson "encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -34,6 +40,22 @@ var (
 	errDummy = errors.New("dummy error")
 )
 
+// cancelledAfter is a context that is cancelled after its error has been
+// checked the given number of times, like a context cancelled in the middle
+// of applying a batch of changes.
+type cancelledAfter struct {
+	context.Context
+	checks int
+}
+
+func (c *cancelledAfter) Err() error {
+	if c.checks <= 0 {
+		return context.Canceled
+	}
+	c.checks--
+	return nil
+}
+
 func TestDocument(t *testing.T) {
 	t.Run("constructor test", func(t *testing.T) {
 		doc := document.New("d1")
@@ -523,4 +545,1072 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, "{}", doc.Marshal())
 		assert.Equal(t, 0, doc.GarbageLen())
 	})
+
+	t.Run("stale selections in GC test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello").Select(0, 5)
+			return nil
+		}))
+
+		// the selections of the local and the applied operations are evicted.
+		assert.Equal(t, 0, doc.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 0, doc.Root().GetText("k1").EvictStaleSelections(time.MaxTicket))
+		assert.Equal(t, 0, doc.RootObject().Get("k1").(*crdt.Text).EvictStaleSelections(time.MaxTicket))
+	})
+
+	t.Run("double counter test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *json.Object) error {
+			root.SetNewCounter("avg", crdt.DoubleCnt, 0.5).
+				Increase(1).
+				Increase(float32(0.25)).
+				Increase(-0.5)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"avg":1.250000}`, doc.Marshal())
+		assert.Equal(t, doc.Marshal(), doc.Root().Marshal())
+	})
+
+	t.Run("duplicate increase delivery test", func(t *testing.T) {
+		doc1 := document.New("d1")
+		err := doc1.Update(func(root *json.Object) error {
+			root.SetNewCounter("cnt", crdt.IntegerCnt, 0).Increase(1).Increase(2)
+			return nil
+		})
+		assert.NoError(t, err)
+		pack := doc1.CreateChangePack()
+
+		doc2 := document.New("d1")
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack))
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, `{"cnt":3}`, doc2.Marshal())
+		assert.Equal(t, `{"cnt":3}`, doc2.Root().Marshal())
+
+		root := crdt.NewRoot(crdt.NewObject(crdt.NewElementRHT(), time.InitialTicket))
+		for _, c := range pack.Changes {
+			for _, op := range c.Operations() {
+				assert.False(t, root.HasApplied(op.ExecutedAt()))
+			}
+			assert.NoError(t, c.Execute(context.Background(), root))
+			assert.NoError(t, c.Execute(context.Background(), root))
+			for _, op := range c.Operations() {
+				assert.True(t, root.HasApplied(op.ExecutedAt()))
+			}
+		}
+		assert.Equal(t, `{"cnt":3}`, root.Object().Marshal())
+	})
+
+	t.Run("replay after snapshot test", func(t *testing.T) {
+		doc1 := document.New("d1")
+		err := doc1.Update(func(root *json.Object) error {
+			root.SetNewCounter("cnt", crdt.IntegerCnt, 0).Increase(1).Increase(2)
+			return nil
+		})
+		assert.NoError(t, err)
+		pack := doc1.CreateChangePack()
+		for i, c := range pack.Changes {
+			c.SetServerSeq(int64(i + 1))
+		}
+		pack.Checkpoint = change.NewCheckpoint(int64(len(pack.Changes)), 0)
+
+		snapshot, err := converter.ObjectToBytes(doc1.RootObject())
+		assert.NoError(t, err)
+
+		doc2 := document.New("d1")
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), &change.Pack{
+			DocumentKey: "d1",
+			Checkpoint:  pack.Checkpoint,
+			Snapshot:    snapshot,
+		}))
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, `{"cnt":3}`, doc2.Marshal())
+		assert.Equal(t, `{"cnt":3}`, doc2.Root().Marshal())
+	})
+
+	t.Run("validate changes test", func(t *testing.T) {
+		doc := document.New("d1")
+		id := change.InitialID.Next()
+		ticket := func(delimiter uint32) *time.Ticket {
+			return time.NewTicket(id.Lamport(), delimiter, id.ActorID())
+		}
+
+		// 01. the following operation can refer to the element created by the
+		// preceding operation in the same batch.
+		objCreatedAt := ticket(1)
+		valid := change.New(id, "", []operations.Operation{
+			operations.NewSet(time.InitialTicket, "obj", crdt.NewObject(crdt.NewElementRHT(), objCreatedAt), objCreatedAt),
+			operations.NewSet(objCreatedAt, "k1", crdt.NewPrimitive("v1", ticket(2)), ticket(2)),
+		})
+		pack := change.NewPack(doc.Key(), change.InitialCheckpoint, []*change.Change{valid}, nil)
+		assert.NoError(t, doc.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, `{"obj":{"k1":"v1"}}`, doc.Marshal())
+
+		// 02. the batch with a malformed operation is not applied at all.
+		id = id.Next()
+		invalid := change.New(id, "", []operations.Operation{
+			operations.NewSet(time.InitialTicket, "k2", crdt.NewPrimitive("v2", ticket(1)), ticket(1)),
+			operations.NewSet(ticket(100), "k3", crdt.NewPrimitive("v3", ticket(2)), ticket(2)),
+		})
+		pack = change.NewPack(doc.Key(), change.InitialCheckpoint, []*change.Change{invalid}, nil)
+		assert.ErrorIs(t, doc.ApplyChangePack(context.Background(), pack), operations.ErrInvalidOperation)
+		assert.Equal(t, `{"obj":{"k1":"v1"}}`, doc.Marshal())
+		assert.Equal(t, `{"obj":{"k1":"v1"}}`, doc.Root().Marshal())
+
+		id = id.Next()
+		wrongType := change.New(id, "", []operations.Operation{
+			operations.NewIncrease(objCreatedAt, crdt.NewPrimitive(1, ticket(1)), ticket(1)),
+		})
+		pack = change.NewPack(doc.Key(), change.InitialCheckpoint, []*change.Change{wrongType}, nil)
+		assert.ErrorIs(t, doc.ApplyChangePack(context.Background(), pack), operations.ErrNotApplicableDataType)
+	})
+
+	t.Run("apply change pack with cancelled context test", func(t *testing.T) {
+		doc1 := document.New("d1")
+		err := doc1.Update(func(root *json.Object) error {
+			root.SetString("k1", "v1")
+			return nil
+		})
+		assert.NoError(t, err)
+		pack := doc1.CreateChangePack()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		doc2 := document.New("d1")
+		assert.ErrorIs(t, doc2.ApplyChangePack(ctx, pack), context.Canceled)
+		assert.Equal(t, `{}`, doc2.Marshal())
+		assert.Equal(t, `{}`, doc2.Root().Marshal())
+		assert.ErrorIs(t, doc2.InternalDocument().ApplyChanges(ctx, pack.Changes...), context.Canceled)
+		assert.Equal(t, `{}`, doc2.InternalDocument().Marshal())
+
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, `{"k1":"v1"}`, doc2.Marshal())
+	})
+
+	t.Run("apply change pack cancelled between changes test", func(t *testing.T) {
+		doc1 := document.New("d1")
+		for _, key := range []string{"k1", "k2", "k3"} {
+			assert.NoError(t, doc1.Update(func(root *json.Object) error {
+				root.SetString(key, "v")
+				return nil
+			}))
+		}
+		pack := doc1.CreateChangePack()
+		assert.Len(t, pack.Changes, 3)
+
+		// the context is cancelled after the first change has been applied,
+		// but none of the changes is applied.
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		doc2 := document.New("d1")
+		assert.ErrorIs(t, doc2.ApplyChangePack(&cancelledAfter{Context: ctx, checks: 2}, pack), context.Canceled)
+		assert.Equal(t, `{}`, doc2.Marshal())
+		assert.Equal(t, `{}`, doc2.Root().Marshal())
+		assert.ErrorIs(t, doc2.InternalDocument().ApplyChanges(
+			&cancelledAfter{Context: ctx, checks: 1},
+			pack.Changes...,
+		), context.Canceled)
+		assert.Equal(t, `{}`, doc2.InternalDocument().Marshal())
+
+		assert.NoError(t, doc2.ApplyChangePack(ctx, pack))
+		assert.Equal(t, `{"k1":"v","k2":"v","k3":"v"}`, doc2.Marshal())
+		assert.Equal(t, doc2.Marshal(), doc2.Root().Marshal())
+	})
+
+	t.Run("version test", func(t *testing.T) {
+		actorA, _ := time.ActorIDFromHex("000000000000000000000001")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000002")
+		doc1 := document.New("d1")
+		doc1.SetActor(actorA)
+		doc2 := document.New("d1")
+		doc2.SetActor(actorB)
+		version := func(doc *document.Document) crdt.Version {
+			return doc.InternalDocument().Root().Version()
+		}
+		assert.True(t, version(doc1).Includes(version(doc2)))
+
+		// 01. The versions of the concurrent updates are incomparable.
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, doc2.Update(func(root *json.Object) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.False(t, version(doc1).Includes(version(doc2)))
+		assert.False(t, version(doc2).Includes(version(doc1)))
+
+		// 02. The version includes the other after applying its changes.
+		before := version(doc1)
+		pack1, pack2 := doc1.CreateChangePack(), doc2.CreateChangePack()
+		assert.NoError(t, doc1.ApplyChangePack(context.Background(), pack2))
+		assert.True(t, version(doc1).Includes(version(doc2)))
+		assert.True(t, version(doc1).Includes(before))
+		assert.False(t, before.Includes(version(doc1)))
+
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack1))
+		assert.True(t, version(doc2).Includes(version(doc1)))
+		assert.True(t, version(doc1).Includes(version(doc2)))
+	})
+
+	t.Run("edit content limit test", func(t *testing.T) {
+		doc1 := document.New("d1")
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "ab")
+			return nil
+		}))
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.GetText("k1").Edit(1, 1, strings.Repeat("🌷", 5))
+			return nil
+		}))
+		pack := doc1.CreateChangePack()
+
+		doc2 := document.New("d1")
+		doc2.SetPolicy(crdt.Policy{MaxEditContentLen: 9})
+		assert.ErrorIs(t, doc2.ApplyChangePack(context.Background(), pack), crdt.ErrContentTooLarge)
+		assert.Equal(t, `{}`, doc2.Marshal())
+		assert.Equal(t, `{}`, doc2.Root().Marshal())
+		assert.Equal(t, `{}`, doc2.InternalDocument().Marshal())
+
+		doc2.SetPolicy(crdt.Policy{MaxEditContentLen: 10})
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("local edit content limit test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "ab")
+			return nil
+		}))
+
+		// the local edit over the limit leaves the text unchanged.
+		doc.SetPolicy(crdt.Policy{MaxEditContentLen: 2})
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			text := root.GetText("k1")
+			assert.PanicsWithError(t, "length 3 over 2: content too large", func() {
+				text.Edit(2, 2, "cde")
+			})
+			assert.Equal(t, "ab", text.String())
+			return nil
+		}))
+		assert.Equal(t, "ab", doc.Root().GetText("k1").String())
+		assert.Equal(t, `{"k1":[{"val":"ab"}]}`, doc.Marshal())
+		assert.Len(t, doc.CreateChangePack().Changes, 1)
+	})
+
+	t.Run("compact log test", func(t *testing.T) {
+		doc := document.New("d1")
+
+		err := doc.Update(func(root *json.Object) error {
+			root.SetString("k1", "v1")
+			root.SetString("k1", "v2")
+			root.SetNewObject("k2").SetInteger("k2.1", 1).SetNewArray("k2.2").AddInteger(1, 2, 3)
+			root.GetObject("k2").GetArray("k2.2").Delete(1)
+			root.SetNewText("k3").Edit(0, 0, "Hello World").Edit(5, 11, "", nil)
+			root.GetText("k3").Style(0, 1, map[string]string{"b": "1"})
+			root.SetNewCounter("k4", crdt.IntegerCnt, 0).Increase(3)
+			root.SetBool("k5", true)
+			root.Delete("k5")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		root := crdt.NewRoot(doc.RootObject().DeepCopy().(*crdt.Object))
+		ops, err := operations.CompactLog(root, time.MaxTicket)
+		assert.NoError(t, err)
+		assert.Len(t, ops, 8)
+
+		replayed := crdt.NewRoot(crdt.NewObject(crdt.NewElementRHT(), time.InitialTicket))
+		for _, op := range ops {
+			assert.NoError(t, op.Execute(context.Background(), replayed))
+		}
+		assert.Equal(t, doc.Marshal(), replayed.Object().Marshal())
+
+		// the elements removed after the safe point are kept as tombstones.
+		ops, err = operations.CompactLog(root, time.InitialTicket)
+		assert.NoError(t, err)
+		assert.Len(t, ops, 12)
+
+		replayed = crdt.NewRoot(crdt.NewObject(crdt.NewElementRHT(), time.InitialTicket))
+		for _, op := range ops {
+			assert.NoError(t, op.Execute(context.Background(), replayed))
+		}
+		assert.Equal(t, doc.Marshal(), replayed.Object().Marshal())
+
+		// the value overwritten by Set is not kept because it can't be seen.
+		assert.Equal(t, 4, doc.GarbageLen())
+		assert.Equal(t, 3, replayed.GarbageLen())
+
+		// the values of the same key are ordered by their creation time.
+		latest := make(map[string]*time.Ticket)
+		for _, op := range ops {
+			if set, ok := op.(*operations.Set); ok {
+				if prev, ok := latest[set.Key()]; ok {
+					assert.True(t, set.ExecutedAt().After(prev))
+				}
+				latest[set.Key()] = set.ExecutedAt()
+			}
+		}
+	})
+
+	t.Run("set tree test", func(t *testing.T) {
+		ticket := func(delimiter uint32) *time.Ticket {
+			return time.NewTicket(1, delimiter, time.InitialActorID)
+		}
+
+		// 01. build a two-level nested object: {"k1":{"k1.1":"v1","k1.2":{"k1.2.1":1}}}.
+		inner := crdt.NewObject(crdt.NewElementRHT(), ticket(3))
+		inner.Set("k1.2.1", crdt.NewPrimitive(1, ticket(4)))
+		outer := crdt.NewObject(crdt.NewElementRHT(), ticket(1))
+		outer.Set("k1.1", crdt.NewPrimitive("v1", ticket(2)))
+		outer.Set("k1.2", inner)
+
+		root := crdt.NewRoot(crdt.NewObject(crdt.NewElementRHT(), time.InitialTicket))
+		op := operations.NewSetTree(time.InitialTicket, "k1", outer, ticket(1))
+		assert.NoError(t, op.Validate(root))
+		assert.NoError(t, op.Execute(context.Background(), root))
+		assert.Equal(t, `{"k1":{"k1.1":"v1","k1.2":{"k1.2.1":1}}}`, root.Object().Marshal())
+
+		// 02. every descendant is registered, so the following operations can
+		// refer to them.
+		for delimiter := uint32(1); delimiter <= 4; delimiter++ {
+			assert.NotNil(t, root.FindByCreatedAt(ticket(delimiter)))
+		}
+		assert.NoError(t, operations.NewRemove(ticket(3), ticket(4), ticket(5)).Execute(context.Background(), root))
+		assert.Equal(t, `{"k1":{"k1.1":"v1","k1.2":{}}}`, root.Object().Marshal())
+		assert.Equal(t, 1, root.GarbageLen())
+
+		// 03. the parent must be an object.
+		op = operations.NewSetTree(ticket(2), "k2", outer, ticket(6))
+		assert.ErrorIs(t, op.Validate(root), operations.ErrNotApplicableDataType)
+	})
+
+	t.Run("apply patch test", func(t *testing.T) {
+		doc := document.New("d1")
+		apply := func(patch string) error {
+			var ops []json.PatchOp
+			assert.NoError(t, gojson.Unmarshal([]byte(patch), &ops))
+			return doc.ApplyPatch(ops)
+		}
+
+		// 01. add
+		assert.NoError(t, apply(`[
+			{"op":"add","path":"/users","value":[{"name":"a"},{"name":"c"}]},
+			{"op":"add","path":"/users/1","value":{"name":"b","age":1.5}},
+			{"op":"add","path":"/users/-","value":{"name":"d"}},
+			{"op":"add","path":"/a~1b","value":{"n":1,"ok":true,"none":null}}
+		]`))
+		assert.Equal(
+			t,
+			`{"a/b":{"n":1,"none":null,"ok":true},`+
+				`"users":[{"name":"a"},{"age":1.500000,"name":"b"},{"name":"c"},{"name":"d"}]}`,
+			doc.Marshal(),
+		)
+
+		// 02. remove
+		assert.NoError(t, apply(`[
+			{"op":"remove","path":"/users/3"},
+			{"op":"remove","path":"/a~1b/none"}
+		]`))
+		assert.Equal(
+			t,
+			`{"a/b":{"n":1,"ok":true},"users":[{"name":"a"},{"age":1.500000,"name":"b"},{"name":"c"}]}`,
+			doc.Marshal(),
+		)
+
+		// 03. replace
+		assert.NoError(t, apply(`[
+			{"op":"replace","path":"/users/0","value":{"name":"A"}},
+			{"op":"replace","path":"/a~1b/n","value":"one"}
+		]`))
+		assert.Equal(
+			t,
+			`{"a/b":{"n":"one","ok":true},"users":[{"name":"A"},{"age":1.500000,"name":"b"},{"name":"c"}]}`,
+			doc.Marshal(),
+		)
+
+		// 04. move within an array and between containers
+		assert.NoError(t, apply(`[
+			{"op":"move","from":"/users/0","path":"/users/2"},
+			{"op":"move","from":"/a~1b/ok","path":"/ok"}
+		]`))
+		assert.Equal(
+			t,
+			`{"a/b":{"n":"one"},"ok":true,"users":[{"age":1.500000,"name":"b"},{"name":"c"},{"name":"A"}]}`,
+			doc.Marshal(),
+		)
+
+		// 05. the patch is applied atomically.
+		expected := doc.Marshal()
+		assert.ErrorIs(t, apply(`[
+			{"op":"add","path":"/x","value":1},
+			{"op":"remove","path":"/users/5"}
+		]`), crdt.ErrOutOfRange)
+		assert.ErrorIs(t, apply(`[{"op":"copy","from":"/ok","path":"/x"}]`), json.ErrUnsupportedPatch)
+		assert.ErrorIs(t, apply(`[{"op":"replace","path":"/y","value":1}]`), crdt.ErrElementNotFound)
+		assert.ErrorIs(t, apply(`[{"op":"move","from":"/users","path":"/users/0"}]`), crdt.ErrInvalidPointer)
+		assert.Equal(t, expected, doc.Marshal())
+
+		// 06. the patch is replicated as the operations of the document.
+		doc2 := document.New("d1")
+		pack := doc.CreateChangePack()
+		pack.MinSyncedTicket = time.InitialTicket
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, doc.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("replay keeps the order of the edits of an actor test", func(t *testing.T) {
+		doc1 := document.New("d1")
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.SetNewArray("list")
+			root.SetNewText("text")
+			return nil
+		}))
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, doc1.Update(func(root *json.Object) error {
+				root.GetArray("list").AddInteger(i*2, i*2+1)
+				root.GetText("text").Edit(0, 0, fmt.Sprintf("%d", i*2+1)).Edit(0, 0, fmt.Sprintf("%d", i*2))
+				return nil
+			}))
+		}
+		assert.Equal(
+			t,
+			`{"list":[0,1,2,3,4,5],"text":[{"val":"4"},{"val":"5"},{"val":"2"},{"val":"3"},{"val":"0"},{"val":"1"}]}`,
+			doc1.Marshal(),
+		)
+
+		doc2 := document.New("d1")
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), doc1.CreateChangePack()))
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("concurrent style and insert converge test", func(t *testing.T) {
+		actorA, _ := time.ActorIDFromHex("000000000000000000000001")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000002")
+		doc1 := document.New("d1")
+		doc1.SetActor(actorA)
+		doc2 := document.New("d1")
+		doc2.SetActor(actorB)
+
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello World")
+			return nil
+		}))
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), doc1.CreateChangePack()))
+
+		// 01. doc1 styles the whole text while doc2 inserts inside of it.
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.GetText("k1").Style(0, 11, map[string]string{"b": "1"})
+			return nil
+		}))
+		assert.NoError(t, doc2.Update(func(root *json.Object) error {
+			root.GetText("k1").Edit(6, 6, "Big ").Edit(0, 0, ">")
+			return nil
+		}))
+
+		// 02. the inserted text is not styled regardless of the order.
+		pack1, pack2 := doc1.CreateChangePack(), doc2.CreateChangePack()
+		assert.NoError(t, doc1.ApplyChangePack(context.Background(), pack2))
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack1))
+		assert.Equal(
+			t,
+			`{"k1":[{"val":">"},{"attrs":{"b":"1"},"val":"Hello "},{"val":"Big "},{"attrs":{"b":"1"},"val":"World"}]}`,
+			doc1.Marshal(),
+		)
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("edit normalized text test", func(t *testing.T) {
+		nfc, nfd := "caf\u00e9", "cafe\u0301"
+		assert.NotEqual(t, nfc, nfd)
+
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, nfd)
+			root.SetNewText("k2").EditNormalized(0, 0, nfd)
+			root.SetNewText("k3").EditNormalized(0, 0, nfc)
+			return nil
+		}))
+
+		// 01. Edit keeps the content as it is, so the forms differ.
+		root := doc.Root()
+		assert.Equal(t, nfd, root.GetText("k1").String())
+		assert.Equal(t, 5, root.GetText("k1").Len())
+
+		// 02. EditNormalized stores both forms in NFC, which is shorter.
+		assert.Equal(t, nfc, root.GetText("k2").String())
+		assert.Equal(t, nfc, root.GetText("k3").String())
+		assert.Equal(t, 4, root.GetText("k2").Len())
+
+		// 03. Other replicas receive the normalized content.
+		doc2 := document.New("d1")
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), doc.CreateChangePack()))
+		assert.Equal(t, doc.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("trim trailing whitespace test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			// the whitespace of a line spans multiple nodes.
+			text := root.SetNewText("k1")
+			text.Edit(0, 0, "a \t")
+			text.Edit(3, 3, "  \nb", map[string]string{"b": "1"})
+			text.Edit(7, 7, " c\t\n\n \n d ")
+
+			assert.Equal(t, 7, text.TrimTrailingWhitespace())
+			assert.Equal(t, "a\nb c\n\n\n d", text.String())
+			assert.Empty(t, text.TrailingWhitespaceRanges())
+			assert.Equal(t, 0, text.TrimTrailingWhitespace())
+			return nil
+		}))
+
+		// each removal is replicated as its own edit operation.
+		doc2 := document.New("d1")
+		pack := doc.CreateChangePack()
+		pack.MinSyncedTicket = time.InitialTicket
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, doc.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("trim trailing whitespace concurrently test", func(t *testing.T) {
+		actorA, _ := time.ActorIDFromHex("000000000000000000000001")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000002")
+		doc1 := document.New("d1")
+		doc1.SetActor(actorA)
+		doc2 := document.New("d1")
+		doc2.SetActor(actorB)
+
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "a  \nb\t\nc ")
+			return nil
+		}))
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), doc1.CreateChangePack()))
+
+		// 01. doc1 trims the lines while doc2 types after the spaces.
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			assert.Equal(t, 4, root.GetText("k1").TrimTrailingWhitespace())
+			return nil
+		}))
+		assert.NoError(t, doc2.Update(func(root *json.Object) error {
+			root.GetText("k1").Edit(3, 3, "x")
+			return nil
+		}))
+
+		// 02. the replicas converge.
+		pack1, pack2 := doc1.CreateChangePack(), doc2.CreateChangePack()
+		pack1.MinSyncedTicket, pack2.MinSyncedTicket = time.InitialTicket, time.InitialTicket
+		assert.NoError(t, doc1.ApplyChangePack(context.Background(), pack2))
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack1))
+		assert.Equal(t, "ax\nb\nc", doc1.Root().GetText("k1").String())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("replace all text test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			text := root.SetNewText("k1")
+			text.Edit(0, 0, "one two one")
+			text.Edit(11, 11, "one")
+			assert.Equal(t, 3, text.ReplaceAll("one", "three"))
+			return nil
+		}))
+		assert.Equal(t, "three two threethree", doc.Root().GetText("k1").String())
+
+		// each replacement is replicated as its own edit operation.
+		doc2 := document.New("d1")
+		pack := doc.CreateChangePack()
+		pack.MinSyncedTicket = time.InitialTicket
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, doc.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("replace all text of different lengths test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			// 01. the occurrences span multiple nodes.
+			text := root.SetNewText("k1")
+			text.Edit(0, 0, "foo ba")
+			text.Edit(6, 6, "r foo", map[string]string{"b": "1"})
+			text.Edit(11, 11, "bar")
+
+			// 02. the replacement is longer than the occurrence.
+			assert.Equal(t, 2, text.ReplaceAll("bar", "yorkie"))
+			assert.Equal(t, "foo yorkie fooyorkie", text.String())
+
+			// 03. the replacement is shorter than the occurrence.
+			assert.Equal(t, 2, text.ReplaceAll("yorkie", "y"))
+			assert.Equal(t, "foo y fooy", text.String())
+			assert.Equal(t, 0, text.ReplaceAll("bar", "baz"))
+			assert.Equal(t, 0, text.ReplaceAll("", "baz"))
+			return nil
+		}))
+
+		doc2 := document.New("d1")
+		pack := doc.CreateChangePack()
+		pack.MinSyncedTicket = time.InitialTicket
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, doc.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("replace all adjacent occurrences test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			// 01. the occurrences are adjacent and don't overlap.
+			text := root.SetNewText("k1")
+			text.Edit(0, 0, "abab")
+			text.Edit(4, 4, "ab")
+			assert.Equal(t, 3, text.ReplaceAll("ab", "xyz"))
+			assert.Equal(t, "xyzxyzxyz", text.String())
+			assert.True(t, text.CheckWeight())
+
+			// 02. the overlapping occurrences are matched from the left.
+			text.Edit(9, 9, "aaa")
+			assert.Equal(t, []int{9}, text.IndexesOf("aa"))
+			assert.Equal(t, 1, text.ReplaceAll("aa", "b"))
+			assert.Equal(t, "xyzxyzxyzba", text.String())
+			return nil
+		}))
+
+		doc2 := document.New("d1")
+		pack := doc.CreateChangePack()
+		pack.MinSyncedTicket = time.InitialTicket
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, doc.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("operation dependencies test", func(t *testing.T) {
+		doc1 := document.New("d1")
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello")
+			root.SetNewArray("k2").AddInteger(1)
+			return nil
+		}))
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.GetText("k1").Edit(5, 5, " World")
+			root.GetArray("k2").AddInteger(2)
+			return nil
+		}))
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.GetText("k1").Style(0, 11, map[string]string{"b": "1"})
+			return nil
+		}))
+
+		var ops []operations.Operation
+		for _, c := range doc1.CreateChangePack().Changes {
+			ops = append(ops, c.Operations()...)
+		}
+
+		// 01. Edit depends on the Text and the nodes of its range.
+		edit := ops[4].(*operations.Edit)
+		assert.Equal(t, []*time.Ticket{
+			edit.ParentCreatedAt(),
+			ops[1].ExecutedAt(),
+		}, edit.Dependencies())
+		add := ops[5].(*operations.Add)
+		assert.Equal(t, []*time.Ticket{add.ParentCreatedAt(), ops[3].ExecutedAt()}, add.Dependencies())
+
+		// 02. a harness buffers the operations until their dependencies have
+		// been applied, while they are delivered in the reverse order.
+		doc2 := document.New("d1")
+		root := doc2.InternalDocument().Root()
+		applied := map[string]bool{time.InitialTicket.Key(): true}
+		var pending []operations.Operation
+		deliver := func(op operations.Operation) {
+			pending = append(pending, op)
+			for progressed := true; progressed; {
+				progressed = false
+				for i, op := range pending {
+					ready := true
+					for _, dep := range op.Dependencies() {
+						ready = ready && applied[dep.Key()]
+					}
+					if !ready {
+						continue
+					}
+
+					assert.NoError(t, op.Execute(context.Background(), root))
+					applied[op.ExecutedAt().Key()] = true
+					pending = append(pending[:i], pending[i+1:]...)
+					progressed = true
+					break
+				}
+			}
+		}
+
+		deliver(ops[6])
+		assert.Equal(t, []operations.Operation{ops[6]}, pending)
+		for i := 5; i > 0; i-- {
+			deliver(ops[i])
+		}
+
+		// the operations of the Text are deferred until the Text is set,
+		// while the ones of the Array have been applied in the causal order.
+		assert.Equal(t, []operations.Operation{ops[6], ops[4], ops[1]}, pending)
+		assert.Equal(t, `{"k2":[1,2]}`, doc2.Marshal())
+
+		deliver(ops[0])
+		assert.Empty(t, pending)
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("attribute inheritance test", func(t *testing.T) {
+		for _, tc := range []struct {
+			inheritance crdt.AttributeInheritance
+			expected    string
+		}{
+			{crdt.InheritNone, `{"k1":[{"attrs":{"b":"1"},"val":"He"},{"val":"xx"},{"attrs":{"b":"1"},"val":"llo"},{"val":"yy"},{"val":" World"}]}`},
+			{crdt.InheritLeft, `{"k1":[{"attrs":{"b":"1"},"val":"He"},{"attrs":{"b":"1"},"val":"xx"},{"attrs":{"b":"1"},"val":"llo"},{"attrs":{"b":"1"},"val":"yy"},{"val":" World"}]}`},
+			{crdt.InheritRight, `{"k1":[{"attrs":{"b":"1"},"val":"He"},{"attrs":{"b":"1"},"val":"xx"},{"attrs":{"b":"1"},"val":"llo"},{"val":"yy"},{"val":" World"}]}`},
+		} {
+			doc := document.New("d1")
+			doc.SetPolicy(crdt.Policy{AttributeInheritance: tc.inheritance})
+			assert.NoError(t, doc.Update(func(root *json.Object) error {
+				text := root.SetNewText("k1").Edit(0, 0, "Hello World")
+				text.Style(0, 5, map[string]string{"b": "1"})
+
+				// 01. insert into the middle of the styled run.
+				text.Edit(2, 2, "xx")
+
+				// 02. insert at the end of the styled run.
+				text.Edit(7, 7, "yy")
+				return nil
+			}))
+			assert.Equal(t, tc.expected, doc.Marshal())
+
+			// the inherited attributes are replicated with the edits.
+			doc2 := document.New("d1")
+			assert.NoError(t, doc2.ApplyChangePack(context.Background(), doc.CreateChangePack()))
+			assert.Equal(t, doc.Marshal(), doc2.Marshal())
+		}
+
+		// the explicit attributes, even empty ones, are not overridden.
+		doc := document.New("d1")
+		doc.SetPolicy(crdt.Policy{AttributeInheritance: crdt.InheritLeft})
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			text := root.SetNewText("k1").Edit(0, 0, "Hello", map[string]string{"b": "1"})
+			text.Edit(5, 5, "!", map[string]string{})
+			text.Edit(5, 5, "?", map[string]string{"i": "1"})
+			return nil
+		}))
+		assert.Equal(t, `{"k1":[{"attrs":{"b":"1"},"val":"Hello"},{"attrs":{"i":"1"},"val":"?"},{"val":"!"}]}`, doc.Marshal())
+	})
+
+	t.Run("locked range test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "Dear NAME, hello").Lock(0, 4)
+			return nil
+		}))
+
+		// the lock is replicated with the style.
+		doc2 := document.New("d1")
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), doc.CreateChangePack()))
+		err := doc2.Update(func(root *json.Object) error {
+			return root.GetText("k1").EditChecked(1, 2, "x", false)
+		})
+		assert.ErrorIs(t, err, crdt.ErrLockedRange)
+		assert.Equal(t, doc.Marshal(), doc2.Marshal())
+
+		// the edits next to the locked range and the privileged edits are
+		// allowed.
+		assert.NoError(t, doc2.Update(func(root *json.Object) error {
+			text := root.GetText("k1")
+			if err := text.EditChecked(5, 9, "Alice", false); err != nil {
+				return err
+			}
+			return text.EditChecked(0, 4, "Hi", true)
+		}))
+		assert.Equal(t, `{"k1":[{"val":"Hi"},{"val":" "},{"val":"Alice"},{"val":", hello"}]}`, doc2.Marshal())
+	})
+
+	t.Run("diff roots test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			root.SetString("k1", "v1")
+			root.SetBool("k2", true)
+			root.SetNewObject("k3").SetNewText("k3.1").Edit(0, 0, "Hello World")
+			root.SetNewArray("k4").AddInteger(1, 2, 3)
+			root.SetNewCounter("k5", crdt.IntegerCnt, 1)
+			return nil
+		}))
+		a := crdt.NewRoot(doc.RootObject().DeepCopy().(*crdt.Object))
+
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			// 01. added keys, including a nested one.
+			root.SetString("k6", "v6")
+			root.GetObject("k3").SetNewObject("k3.2").SetInteger("k3.2.1", 1)
+
+			// 02. removed and replaced keys.
+			root.Delete("k2")
+			root.SetString("k1", "v2")
+
+			// 03. nested text edits and styles.
+			text := root.GetObject("k3").GetText("k3.1")
+			text.Edit(6, 11, "Yorkie")
+			text.Style(0, 5, map[string]string{"b": "1"})
+
+			// 04. array elements and counters.
+			root.GetArray("k4").Delete(0)
+			root.GetArray("k4").AddInteger(4)
+			root.GetCounter("k5").Increase(2)
+			return nil
+		}))
+		b := crdt.NewRoot(doc.RootObject().DeepCopy().(*crdt.Object))
+
+		ops, err := operations.DiffRoots(a, b)
+		assert.NoError(t, err)
+		for _, op := range ops {
+			assert.NoError(t, op.Execute(context.Background(), a))
+		}
+		assert.Equal(t, b.Marshal(), a.Marshal())
+
+		// the removed attributes of the texts are removed.
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			root.GetObject("k3").GetText("k3.1").RemoveStyle(0, 3, "b")
+			return nil
+		}))
+		c := crdt.NewRoot(doc.RootObject().DeepCopy().(*crdt.Object))
+		ops, err = operations.DiffRoots(b, c)
+		assert.NoError(t, err)
+		assert.Len(t, ops, 1)
+		assert.IsType(t, &operations.RemoveStyle{}, ops[0])
+		assert.NoError(t, ops[0].Execute(context.Background(), b))
+		assert.Equal(t, c.Marshal(), b.Marshal())
+
+		// the same versions have no difference.
+		ops, err = operations.DiffRoots(a, a.DeepCopy())
+		assert.NoError(t, err)
+		assert.Empty(t, ops)
+
+		// the keys that the later version doesn't have are removed.
+		ops, err = operations.DiffRoots(b, crdt.NewRoot(crdt.NewObject(crdt.NewElementRHT(), time.InitialTicket)))
+		assert.NoError(t, err)
+		for _, op := range ops {
+			assert.NoError(t, op.Execute(context.Background(), b))
+		}
+		assert.Equal(t, "{}", b.Marshal())
+
+		// the version that has not seen the elements of the other can't be
+		// the later one.
+		older := document.New("d1")
+		assert.NoError(t, older.Update(func(root *json.Object) error {
+			root.SetString("k1", "v0")
+			return nil
+		}))
+		_, err = operations.DiffRoots(a, crdt.NewRoot(older.RootObject()))
+		assert.ErrorIs(t, err, operations.ErrNotDiffable)
+	})
+
+	t.Run("ticket generator test", func(t *testing.T) {
+		actorID, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+
+		edit := func() []string {
+			var seq int64
+			doc := document.New("d1")
+			doc.SetTicketGenerator(time.TicketGeneratorFunc(
+				func(_ int64, _ uint32, _ *time.ActorID) *time.Ticket {
+					seq++
+					return time.NewTicket(seq, 0, actorID)
+				},
+			))
+			assert.NoError(t, doc.Update(func(root *json.Object) error {
+				root.SetNewText("k1").Edit(0, 0, "Hello").Edit(5, 5, " World")
+				return nil
+			}))
+			assert.NoError(t, doc.Update(func(root *json.Object) error {
+				root.GetText("k1").Style(0, 5, map[string]string{"b": "1"})
+				root.SetNewCounter("k2", crdt.IntegerCnt, 0).Increase(1)
+				return nil
+			}))
+
+			var tickets []string
+			for _, c := range doc.CreateChangePack().Changes {
+				for _, op := range c.Operations() {
+					tickets = append(tickets, op.ExecutedAt().StructureAsString())
+				}
+			}
+			return tickets
+		}
+
+		// the same edits issue the same tickets from the generator.
+		tickets := edit()
+		assert.Equal(t, tickets, edit())
+		assert.Equal(t, []string{
+			"1:0:01",
+			"2:0:01",
+			"3:0:01",
+			"4:0:01",
+			"5:0:01",
+			"6:0:01",
+		}, tickets)
+	})
+
+	t.Run("clear object test", func(t *testing.T) {
+		actorA, _ := time.ActorIDFromHex("000000000000000000000001")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000002")
+		doc1 := document.New("d1")
+		doc1.SetActor(actorA)
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.SetNewObject("k1").SetString("a", "1").SetString("b", "2")
+			return nil
+		}))
+		doc2 := document.New("d1")
+		doc2.SetActor(actorB)
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), doc1.CreateChangePack()))
+
+		// doc2 sets a value concurrently with the clear of doc1.
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			assert.Equal(t, 2, root.GetObject("k1").Clear())
+			return nil
+		}))
+		assert.NoError(t, doc2.Update(func(root *json.Object) error {
+			root.GetObject("k1").SetString("c", "3")
+			return nil
+		}))
+
+		pack1, pack2 := doc1.CreateChangePack(), doc2.CreateChangePack()
+		pack1.Changes = pack1.Changes[1:]
+		pack1.MinSyncedTicket, pack2.MinSyncedTicket = time.InitialTicket, time.InitialTicket
+		assert.NoError(t, doc1.ApplyChangePack(context.Background(), pack2))
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack1))
+		assert.Equal(t, `{"k1":{"c":"3"}}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("typing coalescing test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			root.SetNewText("k1").Edit(0, 0, "Hi ")
+			return nil
+		}))
+
+		// 01. the uninterrupted keystrokes are coalesced into one Edit.
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			text := root.GetText("k1").BeginTyping()
+			for i, char := range "Yorkie" {
+				text.Edit(3+i, 3+i, string(char))
+			}
+			text.EndTyping()
+			return nil
+		}))
+		pack := doc.CreateChangePack()
+		assert.Len(t, pack.Changes[1].Operations(), 1)
+		assert.Equal(t, "Yorkie", pack.Changes[1].Operations()[0].(*operations.Edit).Content())
+		assert.Equal(t, `{"k1":[{"val":"Hi "},{"val":"Yorkie"}]}`, doc.Marshal())
+
+		// 02. the keystrokes interrupted by another edit are not coalesced.
+		assert.NoError(t, doc.Update(func(root *json.Object) error {
+			text := root.GetText("k1").BeginTyping()
+			text.Edit(9, 9, "!")
+			text.Style(0, 2, map[string]string{"b": "1"})
+			text.Edit(10, 10, "!")
+			text.EndTyping()
+			return nil
+		}))
+		assert.Len(t, doc.CreateChangePack().Changes[2].Operations(), 3)
+
+		// the replica builds the same nodes from the coalesced edits.
+		doc2 := document.New("d1")
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), doc.CreateChangePack()))
+		assert.Equal(t, doc.Marshal(), doc2.Marshal())
+		assert.Equal(t, doc.Root().GetText("k1").StructureAsString(), doc2.Root().GetText("k1").StructureAsString())
+	})
+
+	t.Run("invert increase test", func(t *testing.T) {
+		for _, tc := range []struct {
+			counterType crdt.CounterType
+			initial     interface{}
+			delta       interface{}
+			expected    string
+		}{
+			{crdt.IntegerCnt, 10, 5, `{"cnt":13}`},
+			{crdt.LongCnt, int64(9000000000000000000), int64(5), `{"cnt":9000000000000000003}`},
+			{crdt.DoubleCnt, 0.5, 0.25, `{"cnt":3.500000}`},
+		} {
+			doc := document.New("d1")
+			assert.NoError(t, doc.Update(func(root *json.Object) error {
+				root.SetNewCounter("cnt", tc.counterType, tc.initial)
+				return nil
+			}))
+			assert.NoError(t, doc.Update(func(root *json.Object) error {
+				root.GetCounter("cnt").Increase(tc.delta)
+				return nil
+			}))
+			changes := doc.CreateChangePack().Changes
+			increase := changes[len(changes)-1].Operations()[0].(*operations.Increase)
+
+			// other increases are interleaved before the inverse is applied.
+			assert.NoError(t, doc.Update(func(root *json.Object) error {
+				root.GetCounter("cnt").Increase(3)
+				return nil
+			}))
+
+			inverse, err := increase.Invert(time.NewTicket(100, 0, time.InitialActorID))
+			assert.NoError(t, err)
+			assert.Equal(t, increase.ParentCreatedAt(), inverse.ParentCreatedAt())
+			assert.NoError(t, inverse.Execute(context.Background(), doc.InternalDocument().Root()))
+			assert.Equal(t, tc.expected, doc.Marshal())
+		}
+
+		// the values that can't be negated are not invertible.
+		for _, value := range []interface{}{int32(math.MinInt32), int64(math.MinInt64), "str"} {
+			increase := operations.NewIncrease(
+				time.InitialTicket,
+				crdt.NewPrimitive(value, time.InitialTicket),
+				time.InitialTicket,
+			)
+			_, err := increase.Invert(time.NewTicket(100, 0, time.InitialActorID))
+			assert.ErrorIs(t, err, operations.ErrNotInvertible)
+		}
+	})
+
+	t.Run("invert set test", func(t *testing.T) {
+		doc1 := document.New("d1")
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.SetString("k1", "v1")
+			root.SetNewCounter("k3", crdt.IntegerCnt, 7)
+			return nil
+		}))
+		doc2 := document.New("d1")
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), doc1.CreateChangePack()))
+
+		// 01. set over the existing keys and set a new key.
+		assert.NoError(t, doc1.Update(func(root *json.Object) error {
+			root.SetString("k1", "v2")
+			root.SetString("k2", "v3")
+			root.SetInteger("k3", 8)
+			return nil
+		}))
+		pack := doc1.CreateChangePack()
+		ops := pack.Changes[len(pack.Changes)-1].Operations()
+		assert.Len(t, ops, 3)
+
+		// 02. the inverses capture the values before the sets are executed.
+		root := doc2.InternalDocument().Root()
+		var inverses []operations.Operation
+		for i, op := range ops {
+			inverse, err := op.(*operations.Set).Invert(root, time.NewTicket(100, uint32(i), time.InitialActorID))
+			assert.NoError(t, err)
+			inverses = append(inverses, inverse)
+		}
+		assert.IsType(t, &operations.Set{}, inverses[0])
+		assert.IsType(t, &operations.Remove{}, inverses[1])
+
+		pack.MinSyncedTicket = time.InitialTicket
+		assert.NoError(t, doc2.ApplyChangePack(context.Background(), pack))
+		assert.Equal(t, `{"k1":"v2","k2":"v3","k3":8}`, doc2.Marshal())
+		_, err := ops[0].(*operations.Set).Invert(root, time.NewTicket(100, 0, time.InitialActorID))
+		assert.ErrorIs(t, err, operations.ErrNotInvertible)
+
+		// 03. the inverses restore the values.
+		for i := len(inverses) - 1; i >= 0; i-- {
+			assert.NoError(t, inverses[i].Execute(context.Background(), root))
+		}
+		assert.Equal(t, `{"k1":"v1","k3":7}`, doc2.Marshal())
+	})
 }
@@ -17,6 +17,9 @@
 package document
 
 import (
+	"context"
+	gotime "time"
+
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
@@ -95,15 +98,21 @@ func (d *InternalDocument) HasLocalChanges() bool {
 	return len(d.localChanges) > 0
 }
 
-// ApplyChangePack applies the given change pack into this document.
-func (d *InternalDocument) ApplyChangePack(pack *change.Pack) error {
+// ApplyChangePack applies the given change pack into this document. If the
+// given context is done while applying the changes, the error of the context
+// is returned and the document is left untouched.
+func (d *InternalDocument) ApplyChangePack(ctx context.Context, pack *change.Pack) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// 01. Apply remote changes to both the clone and the document.
 	if len(pack.Snapshot) > 0 {
 		if err := d.applySnapshot(pack.Snapshot, pack.Checkpoint.ServerSeq); err != nil {
 			return err
 		}
 	} else {
-		if err := d.ApplyChanges(pack.Changes...); err != nil {
+		if err := d.applyChanges(ctx, pack.Changes); err != nil {
 			return err
 		}
 	}
@@ -139,7 +148,7 @@ func (d *InternalDocument) GarbageLen() int {
 
 // Marshal returns the JSON encoding of this document.
 func (d *InternalDocument) Marshal() string {
-	return d.root.Object().Marshal()
+	return d.root.Marshal()
 }
 
 // CreateChangePack creates pack of the local changes to send to the server.
@@ -179,6 +188,18 @@ func (d *InternalDocument) IsAttached() bool {
 	return d.status == Attached
 }
 
+// SetPolicy sets the policy that limits the remote changes applied to this
+// document.
+func (d *InternalDocument) SetPolicy(policy crdt.Policy) {
+	d.root.SetPolicy(policy)
+}
+
+// SetTicketGenerator sets the generator that issues the tickets of the local
+// operations of this document.
+func (d *InternalDocument) SetTicketGenerator(generator time.TicketGenerator) {
+	d.root.SetTicketGenerator(generator)
+}
+
 // Root returns the root of this document.
 func (d *InternalDocument) Root() *crdt.Root {
 	return d.root
@@ -195,20 +216,84 @@ func (d *InternalDocument) applySnapshot(snapshot []byte, serverSeq int64) error
 		return err
 	}
 
+	policy, generator := d.root.Policy(), d.root.TicketGenerator()
 	d.root = crdt.NewRoot(rootObj)
+	d.root.SetPolicy(policy)
+	d.root.SetTicketGenerator(generator)
 	d.changeID = d.changeID.SyncLamport(serverSeq)
 
 	return nil
 }
 
-// ApplyChanges applies remote changes to the document.
-func (d *InternalDocument) ApplyChanges(changes ...*change.Change) error {
+// ApplyChanges applies remote changes to the document. The changes are
+// validated before any of them is applied, and the given context is checked
+// between the changes. If it is done, the error of the context is returned
+// and the document is left untouched.
+func (d *InternalDocument) ApplyChanges(ctx context.Context, changes ...*change.Change) error {
+	return d.applyChanges(ctx, changes)
+}
+
+// applyChanges applies the given remote changes to the document after
+// validating them. If the given context can be cancelled, the changes are
+// applied to a copy of the root, which replaces the root only after all of
+// them have been applied, so that a cancelled batch is not applied partially.
+func (d *InternalDocument) applyChanges(ctx context.Context, changes []*change.Change) error {
+	changes = d.unreceivedChanges(changes)
+	if err := change.ValidateChanges(d.root, changes); err != nil {
+		return err
+	}
+
+	root := d.root
+	if ctx.Done() != nil && len(changes) > 0 {
+		root = d.root.DeepCopy()
+	}
+
+	changeID := d.changeID
 	for _, c := range changes {
-		if err := c.Execute(d.root); err != nil {
+		if err := c.Execute(ctx, root); err != nil {
 			return err
 		}
-		d.changeID = d.changeID.SyncLamport(c.ID().Lamport())
+		changeID = changeID.SyncLamport(c.ID().Lamport())
 	}
 
+	d.root = root
+	d.changeID = changeID
 	return nil
 }
+
+// uncancelableContext carries the values of the given context, but it is
+// never done. It is used to apply the changes that have already been applied
+// to the clone, so that the document doesn't diverge from the clone.
+type uncancelableContext struct {
+	context.Context
+}
+
+// Deadline returns no deadline.
+func (uncancelableContext) Deadline() (gotime.Time, bool) {
+	return gotime.Time{}, false
+}
+
+// Done returns nil, so the context is never done.
+func (uncancelableContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Err returns nil, so the context is never done.
+func (uncancelableContext) Err() error {
+	return nil
+}
+
+// unreceivedChanges returns the given changes except the ones that have
+// already been received by the checkpoint of this document. The root loaded
+// from a snapshot doesn't know which changes are reflected in the snapshot,
+// so the changes replayed after the snapshot are skipped by the checkpoint.
+func (d *InternalDocument) unreceivedChanges(changes []*change.Change) []*change.Change {
+	var unreceived []*change.Change
+	for _, c := range changes {
+		if !d.checkpoint.Includes(c.ID()) {
+			unreceived = append(unreceived, c)
+		}
+	}
+
+	return unreceived
+}
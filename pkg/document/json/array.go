@@ -200,9 +200,11 @@ func (p *Array) insertAfterInternal(
 }
 
 func (p *Array) moveBeforeInternal(nextCreatedAt, createdAt *time.Ticket) {
-	ticket := p.context.IssueTimeTicket()
+	p.moveAfterInternal(p.FindPrevCreatedAt(nextCreatedAt), createdAt)
+}
 
-	prevCreatedAt := p.FindPrevCreatedAt(nextCreatedAt)
+func (p *Array) moveAfterInternal(prevCreatedAt, createdAt *time.Ticket) {
+	ticket := p.context.IssueTimeTicket()
 
 	p.context.Push(operations.NewMove(
 		p.Array.CreatedAt(),
@@ -67,6 +67,12 @@ func (p *Counter) Increase(v interface{}) *Counter {
 		} else {
 			primitive = crdt.NewPrimitive(int32(value.(float64)), ticket)
 		}
+	case crdt.DoubleCnt:
+		if isInt {
+			primitive = crdt.NewPrimitive(float64(value.(int)), ticket)
+		} else {
+			primitive = crdt.NewPrimitive(value.(float64), ticket)
+		}
 	default:
 		panic("unsupported type")
 	}
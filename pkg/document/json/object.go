@@ -17,6 +17,7 @@
 package json
 
 import (
+	"sort"
 	gotime "time"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
@@ -84,6 +85,11 @@ func (p *Object) SetNewCounter(k string, t crdt.CounterType, n interface{}) *Cou
 				p.context,
 				crdt.NewCounter(crdt.LongCnt, n, ticket),
 			)
+		case crdt.DoubleCnt:
+			return NewCounter(
+				p.context,
+				crdt.NewCounter(crdt.DoubleCnt, n, ticket),
+			)
 		default:
 			panic("unsupported type")
 		}
@@ -92,6 +98,37 @@ func (p *Object) SetNewCounter(k string, t crdt.CounterType, n interface{}) *Cou
 	return v.(*Counter)
 }
 
+// SetNewTree sets a new Object for the given key with the given decoded JSON
+// members, such as map[string]interface{} or []interface{}. Unlike
+// SetNewObject followed by setting the members one by one, the whole subtree
+// is created by a single SetTree operation, so the other replicas never see
+// it half-built.
+func (p *Object) SetNewTree(k string, members map[string]interface{}) (*Object, error) {
+	value, err := p.buildTree(members)
+	if err != nil {
+		return nil, err
+	}
+
+	p.context.Push(operations.NewSetTree(
+		p.CreatedAt(),
+		k,
+		value.DeepCopy(),
+		value.CreatedAt(),
+	))
+
+	removed := p.Set(k, value)
+	p.context.RegisterElement(value)
+	value.(crdt.Container).Descendants(func(elem crdt.Element, _ crdt.Container) bool {
+		p.context.RegisterElement(elem)
+		return false
+	})
+	if removed != nil {
+		p.context.RegisterRemovedElementPair(p, removed)
+	}
+
+	return NewObject(p.context, value.(*crdt.Object)), nil
+}
+
 // SetNull sets the null for the given key.
 func (p *Object) SetNull(k string) *Object {
 	p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
@@ -181,6 +218,20 @@ func (p *Object) Delete(k string) crdt.Element {
 	return deleted
 }
 
+// Clear deletes all the members of this object and returns the number of the
+// deleted members. Each member is deleted by its own Remove operation like
+// Delete, so the values set concurrently by other replicas survive the clear.
+func (p *Object) Clear() int {
+	count := 0
+	for _, k := range p.Object.Keys() {
+		if p.Delete(k) != nil {
+			count++
+		}
+	}
+
+	return count
+}
+
 // GetObject returns Object of the given key.
 func (p *Object) GetObject(k string) *Object {
 	elem := p.Object.Get(k)
@@ -272,3 +323,43 @@ func (p *Object) setInternal(
 
 	return elem
 }
+
+// buildTree builds the detached element of the given decoded JSON value. The
+// ticket of a container is issued before the ones of its children, so the
+// subtree is created in the same order as if it were built one by one.
+func (p *Object) buildTree(value interface{}) (crdt.Element, error) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		obj := crdt.NewObject(crdt.NewElementRHT(), p.context.IssueTimeTicket())
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			child, err := p.buildTree(value[k])
+			if err != nil {
+				return nil, err
+			}
+			obj.Set(k, child)
+		}
+		return obj, nil
+	case []interface{}:
+		arr := crdt.NewArray(crdt.NewRGATreeList(), p.context.IssueTimeTicket())
+		for _, v := range value {
+			child, err := p.buildTree(v)
+			if err != nil {
+				return nil, err
+			}
+			arr.Add(child)
+		}
+		return arr, nil
+	}
+
+	primitive, err := toPrimitiveValue(value)
+	if err != nil {
+		return nil, err
+	}
+	return crdt.NewPrimitive(primitive, p.context.IssueTimeTicket()), nil
+}
@@ -0,0 +1,414 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// The operations of JSON Patch(RFC 6902) that can be applied to the document.
+const (
+	PatchAdd     = "add"
+	PatchRemove  = "remove"
+	PatchReplace = "replace"
+	PatchMove    = "move"
+)
+
+// ErrUnsupportedPatch occurs when the patch has an operation or a value that
+// can't be applied to the document.
+var ErrUnsupportedPatch = errors.New("unsupported patch")
+
+// PatchOp represents an operation of JSON Patch(RFC 6902). The value is a
+// decoded JSON value, such as map[string]interface{} or []interface{}.
+type PatchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ApplyPatch applies the given JSON Patch to this object, which is the root
+// of the patch paths. Each operation of the patch is translated into the
+// operations of the document, such as Set, Remove, Add and Move, so that it
+// is replicated like the other updates. If an operation fails, the error is
+// returned and the operations applied so far are left in this object, so it
+// should be called in Document.Update, which discards them.
+func (p *Object) ApplyPatch(patch []PatchOp) error {
+	for i, op := range patch {
+		if err := p.applyPatchOp(op); err != nil {
+			return fmt.Errorf("patch %d(%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Object) applyPatchOp(op PatchOp) error {
+	switch op.Op {
+	case PatchAdd:
+		return p.patchAdd(op.Path, op.Value)
+	case PatchRemove:
+		_, err := p.patchRemove(op.Path)
+		return err
+	case PatchReplace:
+		return p.patchReplace(op.Path, op.Value)
+	case PatchMove:
+		return p.patchMove(op.From, op.Path)
+	}
+
+	return fmt.Errorf("op %q: %w", op.Op, ErrUnsupportedPatch)
+}
+
+// patchAdd sets the value to the key of the object, or inserts it before the
+// index of the array.
+func (p *Object) patchAdd(path string, value interface{}) error {
+	parent, token, err := p.resolveParent(path)
+	if err != nil {
+		return err
+	}
+
+	switch parent := parent.(type) {
+	case *Object:
+		return parent.setValue(token, value)
+	case *Array:
+		idx := parent.Len()
+		if token != "-" {
+			if idx, err = crdt.ParseArrayIndex(token); err != nil {
+				return err
+			}
+			if idx > parent.Len() {
+				return fmt.Errorf("index %d of length %d: %w", idx, parent.Len(), crdt.ErrOutOfRange)
+			}
+		}
+		_, err = parent.insertValue(parent.prevCreatedAtOf(idx), value)
+		return err
+	}
+
+	return fmt.Errorf("%s: %w", path, ErrUnsupportedPatch)
+}
+
+// patchRemove removes the element of the given path and returns it.
+func (p *Object) patchRemove(path string) (crdt.Element, error) {
+	parent, token, err := p.resolveParent(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch parent := parent.(type) {
+	case *Object:
+		if !parent.Has(token) {
+			return nil, fmt.Errorf("%s: %w", path, crdt.ErrElementNotFound)
+		}
+		return parent.Delete(token), nil
+	case *Array:
+		idx, err := parent.indexOf(token)
+		if err != nil {
+			return nil, err
+		}
+		return parent.Delete(idx), nil
+	}
+
+	return nil, fmt.Errorf("%s: %w", path, ErrUnsupportedPatch)
+}
+
+// patchReplace replaces the existing element of the given path with the
+// given value.
+func (p *Object) patchReplace(path string, value interface{}) error {
+	parent, token, err := p.resolveParent(path)
+	if err != nil {
+		return err
+	}
+
+	switch parent := parent.(type) {
+	case *Object:
+		if !parent.Has(token) {
+			return fmt.Errorf("%s: %w", path, crdt.ErrElementNotFound)
+		}
+		return parent.setValue(token, value)
+	case *Array:
+		idx, err := parent.indexOf(token)
+		if err != nil {
+			return err
+		}
+		parent.Delete(idx)
+		_, err = parent.insertValue(parent.prevCreatedAtOf(idx), value)
+		return err
+	}
+
+	return fmt.Errorf("%s: %w", path, ErrUnsupportedPatch)
+}
+
+// patchMove moves the element of the given path(`from`) to the given
+// path(`to`). The element moved within an array is moved by Move operation,
+// and the other elements are copied to the new path and removed from the old
+// path.
+func (p *Object) patchMove(from, to string) error {
+	if from == to {
+		return nil
+	}
+	if strings.HasPrefix(to, from+"/") {
+		return fmt.Errorf("move %s into its child: %w", from, crdt.ErrInvalidPointer)
+	}
+
+	fromParent, fromToken, err := p.resolveParent(from)
+	if err != nil {
+		return err
+	}
+	toParent, toToken, err := p.resolveParent(to)
+	if err != nil {
+		return err
+	}
+
+	if fromArray, ok := fromParent.(*Array); ok {
+		if toArray, ok := toParent.(*Array); ok && fromArray.CreatedAt().Compare(toArray.CreatedAt()) == 0 {
+			return fromArray.moveByIndex(fromToken, toToken)
+		}
+	}
+
+	tokens, err := crdt.ParsePointer(from)
+	if err != nil {
+		return err
+	}
+	elem, err := crdt.ResolveTokens(p.Object, tokens)
+	if err != nil {
+		return err
+	}
+	value, err := toPatchValue(elem)
+	if err != nil {
+		return err
+	}
+
+	if _, err := p.patchRemove(from); err != nil {
+		return err
+	}
+	return p.patchAdd(to, value)
+}
+
+// resolveParent returns the proxy of the container of the given path and
+// the last reference token of the path.
+func (p *Object) resolveParent(path string) (crdt.Element, string, error) {
+	tokens, err := crdt.ParsePointer(path)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(tokens) == 0 {
+		return nil, "", fmt.Errorf("the root as the target: %w", ErrUnsupportedPatch)
+	}
+
+	parent, err := crdt.ResolveTokens(p.Object, tokens[:len(tokens)-1])
+	if err != nil {
+		return nil, "", err
+	}
+
+	token := tokens[len(tokens)-1]
+	switch parent := parent.(type) {
+	case *crdt.Object:
+		return NewObject(p.context, parent), token, nil
+	case *crdt.Array:
+		return NewArray(p.context, parent), token, nil
+	}
+
+	return nil, "", fmt.Errorf("%s: %T is not a container: %w", path, parent, crdt.ErrElementNotFound)
+}
+
+// setValue sets the given decoded JSON value to the given key.
+func (p *Object) setValue(k string, value interface{}) error {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		return p.SetNewObject(k).setMembers(value)
+	case []interface{}:
+		return p.SetNewArray(k).addValues(value)
+	}
+
+	primitive, err := toPrimitiveValue(value)
+	if err != nil {
+		return err
+	}
+	p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
+		return crdt.NewPrimitive(primitive, ticket)
+	})
+
+	return nil
+}
+
+// setMembers sets the members of the given decoded JSON object in the order
+// of the keys.
+func (p *Object) setMembers(members map[string]interface{}) error {
+	keys := make([]string, 0, len(members))
+	for k := range members {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if err := p.setValue(k, members[k]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertValue inserts the given decoded JSON value after the given previous
+// element.
+func (p *Array) insertValue(prevCreatedAt *time.Ticket, value interface{}) (crdt.Element, error) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		obj := p.insertAfterInternal(prevCreatedAt, func(ticket *time.Ticket) crdt.Element {
+			return NewObject(p.context, crdt.NewObject(crdt.NewElementRHT(), ticket))
+		}).(*Object)
+		return obj, obj.setMembers(value)
+	case []interface{}:
+		arr := p.insertAfterInternal(prevCreatedAt, func(ticket *time.Ticket) crdt.Element {
+			return NewArray(p.context, crdt.NewArray(crdt.NewRGATreeList(), ticket))
+		}).(*Array)
+		return arr, arr.addValues(value)
+	}
+
+	primitive, err := toPrimitiveValue(value)
+	if err != nil {
+		return nil, err
+	}
+	return p.insertAfterInternal(prevCreatedAt, func(ticket *time.Ticket) crdt.Element {
+		return crdt.NewPrimitive(primitive, ticket)
+	}), nil
+}
+
+// addValues adds the given decoded JSON values at the last.
+func (p *Array) addValues(values []interface{}) error {
+	for _, value := range values {
+		if _, err := p.insertValue(p.Array.LastCreatedAt(), value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// moveByIndex moves the element of the given index(`from`) to the given
+// index(`to`), which is the index after the element is removed.
+func (p *Array) moveByIndex(from, to string) error {
+	fromIdx, err := p.indexOf(from)
+	if err != nil {
+		return err
+	}
+	toIdx := p.Len() - 1
+	if to != "-" {
+		if toIdx, err = p.indexOf(to); err != nil {
+			return err
+		}
+	}
+	if fromIdx == toIdx {
+		return nil
+	}
+
+	var others []crdt.Element
+	for _, elem := range p.Elements() {
+		if elem != p.Get(fromIdx) {
+			others = append(others, elem)
+		}
+	}
+
+	prevCreatedAt := time.InitialTicket
+	if toIdx > 0 {
+		prevCreatedAt = others[toIdx-1].CreatedAt()
+	}
+	p.moveAfterInternal(prevCreatedAt, p.Get(fromIdx).CreatedAt())
+
+	return nil
+}
+
+// indexOf returns the index of the existing element of the given reference
+// token.
+func (p *Array) indexOf(token string) (int, error) {
+	idx, err := crdt.ParseArrayIndex(token)
+	if err != nil {
+		return 0, err
+	}
+	if idx >= p.Len() {
+		return 0, fmt.Errorf("index %d of length %d: %w", idx, p.Len(), crdt.ErrOutOfRange)
+	}
+
+	return idx, nil
+}
+
+// prevCreatedAtOf returns the creation time of the element before the given
+// index.
+func (p *Array) prevCreatedAtOf(idx int) *time.Ticket {
+	if idx == 0 {
+		return time.InitialTicket
+	}
+	return p.Get(idx - 1).CreatedAt()
+}
+
+// toPrimitiveValue returns the value of Primitive for the given decoded JSON
+// value. A whole number is stored as an integer.
+func toPrimitiveValue(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case nil, bool, string, int, int64:
+		return value, nil
+	case float64:
+		if value == math.Trunc(value) && math.Abs(value) <= 1<<53 {
+			return int(value), nil
+		}
+		return value, nil
+	}
+
+	return nil, fmt.Errorf("value of %T: %w", value, ErrUnsupportedPatch)
+}
+
+// toPatchValue returns the decoded JSON value of the given element. Text and
+// Counter can't be copied to another container because they can't be
+// represented by a plain JSON value without losing their history.
+func toPatchValue(elem crdt.Element) (interface{}, error) {
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		members := make(map[string]interface{})
+		var err error
+		elem.ForEach(func(k string, child crdt.Element) bool {
+			members[k], err = toPatchValue(child)
+			return err == nil
+		})
+		return members, err
+	case *crdt.Array:
+		values := []interface{}{}
+		for _, child := range elem.Elements() {
+			value, err := toPatchValue(child)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case *crdt.Primitive:
+		switch value := elem.Value().(type) {
+		case int32:
+			return int(value), nil
+		case nil, bool, string, int64, float64:
+			return value, nil
+		}
+	}
+
+	return nil, fmt.Errorf("move %T to another container: %w", elem, ErrUnsupportedPatch)
+}
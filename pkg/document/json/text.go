@@ -17,6 +17,10 @@
 package json
 
 import (
+	"unicode/utf16"
+
+	"golang.org/x/text/unicode/norm"
+
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/operations"
@@ -37,22 +41,34 @@ func NewText(ctx *change.Context, text *crdt.Text) *Text {
 	}
 }
 
-// Edit edits the given range with the given content and attributes.
+// Edit edits the given range with the given content and attributes. If no
+// attributes are given, the content inherits the attributes of the
+// neighboring character according to the AttributeInheritance of the
+// document policy. An empty map can be given to insert without attributes.
+// It panics if the content exceeds the limit of the document policy.
 func (p *Text) Edit(from, to int, content string, attributes ...map[string]string) *Text {
 	if from > to {
 		panic("from should be less than or equal to to")
 	}
-	fromPos, toPos := p.Text.CreateRange(from, to)
+	if err := p.context.Policy().CheckEditContent(content); err != nil {
+		panic(err)
+	}
 
 	// TODO(hackerwins): We need to consider the case where the length of
 	//  attributes is greater than 1.
 	var attrs map[string]string
-	if len(attributes) > 0 {
+	if len(attributes) > 0 && attributes[0] != nil {
 		attrs = attributes[0]
+	} else if len(content) > 0 {
+		attrs = p.Text.InheritedAttributes(from, to, p.context.Policy().AttributeInheritance)
+	}
+	fromPos, toPos := p.Text.CreateRange(from, to)
+	if from == to && p.coalesce(fromPos, content, attrs) {
+		return p
 	}
 
 	ticket := p.context.IssueTimeTicket()
-	_, maxCreationMapByActor := p.Text.Edit(
+	_, maxCreationMapByActor, err := p.Text.Edit(
 		fromPos,
 		toPos,
 		nil,
@@ -60,6 +76,9 @@ func (p *Text) Edit(from, to int, content string, attributes ...map[string]strin
 		attrs,
 		ticket,
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	p.context.Push(operations.NewEdit(
 		p.CreatedAt(),
@@ -77,6 +96,159 @@ func (p *Text) Edit(from, to int, content string, attributes ...map[string]strin
 	return p
 }
 
+// EditEmbed edits the given range with the embedded inline object of the
+// given payload, such as an image or a mention, and attributes.
+func (p *Text) EditEmbed(from, to int, embed map[string]string, attributes ...map[string]string) *Text {
+	if from > to {
+		panic("from should be less than or equal to to")
+	}
+
+	var attrs map[string]string
+	if len(attributes) > 0 {
+		attrs = attributes[0]
+	}
+	fromPos, toPos := p.Text.CreateRange(from, to)
+
+	ticket := p.context.IssueTimeTicket()
+	_, maxCreationMapByActor, err := p.Text.EditEmbed(
+		fromPos,
+		toPos,
+		nil,
+		embed,
+		attrs,
+		ticket,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	p.context.Push(operations.NewEditEmbed(
+		p.CreatedAt(),
+		fromPos,
+		toPos,
+		maxCreationMapByActor,
+		embed,
+		attrs,
+		ticket,
+	))
+	if !fromPos.Equal(toPos) {
+		p.context.RegisterTextElementWithGarbage(p)
+	}
+
+	return p
+}
+
+// BeginTyping begins the typing session of the actor of this document. In
+// the session, the consecutive single-character inserts at the adjacent
+// positions in the same update are coalesced into the Edit of the first one,
+// so that typing quickly doesn't make a node per keystroke. The coalescing
+// is abandoned if the text is changed otherwise between the keystrokes, such
+// as by a remote change.
+func (p *Text) BeginTyping() *Text {
+	p.Text.BeginTyping(p.context.ID().ActorID())
+	return p
+}
+
+// EndTyping ends the typing session.
+func (p *Text) EndTyping() *Text {
+	p.Text.EndTyping()
+	return p
+}
+
+// coalesce appends the given content inserted at the given position to the
+// last Edit of this context if it is the keystroke right after the one of the
+// Edit in the typing session.
+func (p *Text) coalesce(pos *crdt.RGATreeSplitNodePos, content string, attrs map[string]string) bool {
+	last, ok := p.context.LastOperation().(*operations.Edit)
+	if !ok || last.Embed() != nil || last.ParentCreatedAt().Compare(p.CreatedAt()) != 0 {
+		return false
+	}
+
+	if _, ok := p.Text.CoalesceInsert(pos, content, attrs, last.ExecutedAt()); !ok {
+		return false
+	}
+
+	last.AppendContent(content)
+	return true
+}
+
+// EditChecked edits the given range like Edit, but it returns an error
+// wrapping crdt.ErrLockedRange without editing if the range touches a range
+// locked by Lock, unless privileged is true, for example for the owner of a
+// template.
+func (p *Text) EditChecked(
+	from, to int,
+	content string,
+	privileged bool,
+	attributes ...map[string]string,
+) error {
+	if !privileged {
+		if err := p.Text.CheckLocked(from, to); err != nil {
+			return err
+		}
+	}
+
+	p.Edit(from, to, content, attributes...)
+	return nil
+}
+
+// Lock locks the given range, so that EditChecked rejects the edits touching
+// it. The lock is an attribute of the characters set by Style, so it is
+// replicated to the other replicas.
+func (p *Text) Lock(from, to int) *Text {
+	return p.Style(from, to, crdt.LockAttributes(true))
+}
+
+// Unlock unlocks the given range locked by Lock.
+func (p *Text) Unlock(from, to int) *Text {
+	return p.Style(from, to, crdt.LockAttributes(false))
+}
+
+// EditNormalized edits the given range like Edit, but the content is
+// normalized to NFC before it is inserted, so that the same text typed in
+// different normal forms, such as a precomposed accented character and a
+// character followed by a combining mark, is stored the same.
+//
+// The offsets of the range are the ones of the current content and are not
+// affected, but the length of the inserted content may be shorter than the
+// given one in UTF-16 code units, so the offsets after the range should be
+// calculated with the length of the normalized content. The normalization is
+// done before the operation is created, so other replicas receive the
+// normalized content.
+func (p *Text) EditNormalized(from, to int, content string, attributes ...map[string]string) *Text {
+	return p.Edit(from, to, norm.NFC.String(content), attributes...)
+}
+
+// TrimTrailingWhitespace removes the spaces and tabs at the end of each line
+// and returns the number of the removed code units. Each range is removed by
+// its own edit operation, so the removal is replicated like other edits.
+func (p *Text) TrimTrailingWhitespace() int {
+	ranges := p.Text.TrailingWhitespaceRanges()
+
+	count := 0
+	for i := len(ranges) - 1; i >= 0; i-- {
+		p.Edit(ranges[i][0], ranges[i][1], "")
+		count += ranges[i][1] - ranges[i][0]
+	}
+
+	return count
+}
+
+// ReplaceAll replaces all the non-overlapping occurrences of the given string
+// with the replacement and returns the number of the replaced occurrences.
+// Like TrimTrailingWhitespace, each occurrence is replaced by its own edit
+// operation from the last one.
+func (p *Text) ReplaceAll(search, replacement string) int {
+	offsets := p.Text.IndexesOf(search)
+	length := len(utf16.Encode([]rune(search)))
+
+	for i := len(offsets) - 1; i >= 0; i-- {
+		p.Edit(offsets[i], offsets[i]+length, replacement)
+	}
+
+	return len(offsets)
+}
+
 // Style applies the style of the given range.
 func (p *Text) Style(from, to int, attributes map[string]string) *Text {
 	if from > to {
@@ -85,17 +257,22 @@ func (p *Text) Style(from, to int, attributes map[string]string) *Text {
 	fromPos, toPos := p.Text.CreateRange(from, to)
 
 	ticket := p.context.IssueTimeTicket()
-	p.Text.Style(
+	maxCreationMapByActor, err := p.Text.Style(
 		fromPos,
 		toPos,
+		nil,
 		attributes,
 		ticket,
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	p.context.Push(operations.NewStyle(
 		p.CreatedAt(),
 		fromPos,
 		toPos,
+		maxCreationMapByActor,
 		attributes,
 		ticket,
 	))
@@ -103,6 +280,42 @@ func (p *Text) Style(from, to int, attributes map[string]string) *Text {
 	return p
 }
 
+// RemoveStyle removes the attributes of the given keys from the given range.
+func (p *Text) RemoveStyle(from, to int, keys ...string) *Text {
+	if from > to {
+		panic("from should be less than or equal to to")
+	}
+	fromPos, toPos := p.Text.CreateRange(from, to)
+
+	ticket := p.context.IssueTimeTicket()
+	maxCreationMapByActor, err := p.Text.RemoveStyle(
+		fromPos,
+		toPos,
+		nil,
+		keys,
+		ticket,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	p.context.Push(operations.NewRemoveStyle(
+		p.CreatedAt(),
+		fromPos,
+		toPos,
+		maxCreationMapByActor,
+		keys,
+		ticket,
+	))
+
+	return p
+}
+
+// ClearAllStyles removes all the attributes of the whole content.
+func (p *Text) ClearAllStyles() *Text {
+	return p.RemoveStyle(0, p.Len(), p.Text.StyleKeys()...)
+}
+
 // Select stores that the given range has been selected.
 func (p *Text) Select(from, to int) *Text {
 	if from > to {
@@ -116,6 +329,7 @@ func (p *Text) Select(from, to int) *Text {
 		toPos,
 		ticket,
 	)
+	p.context.RegisterTextWithSelection(p.Text)
 
 	p.context.Push(operations.NewSelect(
 		p.CreatedAt(),
@@ -17,6 +17,9 @@
 package operations
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -52,7 +55,7 @@ func NewAdd(
 }
 
 // Execute executes this operation on the given document(`root`).
-func (o *Add) Execute(root *crdt.Root) error {
+func (o *Add) Execute(_ context.Context, root *crdt.Root) error {
 	parent := root.FindByCreatedAt(o.parentCreatedAt)
 
 	obj, ok := parent.(*crdt.Array)
@@ -67,6 +70,26 @@ func (o *Add) Execute(root *crdt.Root) error {
 	return nil
 }
 
+// Validate checks whether this operation is well-formed and can be executed
+// on the given document(`root`).
+func (o *Add) Validate(root *crdt.Root) error {
+	parent, err := findParent(root, "add", o.parentCreatedAt, o.executedAt)
+	if err != nil {
+		return err
+	}
+	if _, ok := parent.(*crdt.Array); !ok {
+		return fmt.Errorf("add: %w", ErrNotApplicableDataType)
+	}
+	if o.prevCreatedAt == nil || o.value == nil || o.value.CreatedAt() == nil {
+		return fmt.Errorf("add: missing value: %w", ErrInvalidOperation)
+	}
+	if err := crdt.ValidateElementType(o.value); err != nil {
+		return fmt.Errorf("add: %w", err)
+	}
+
+	return nil
+}
+
 // Value returns the value of this operation.
 func (o *Add) Value() crdt.Element {
 	return o.value
@@ -77,6 +100,12 @@ func (o *Add) ParentCreatedAt() *time.Ticket {
 	return o.parentCreatedAt
 }
 
+// Dependencies returns the creation times of the parent Array and the
+// previous element to insert after.
+func (o *Add) Dependencies() []*time.Ticket {
+	return dependenciesOf(o.parentCreatedAt, o.prevCreatedAt)
+}
+
 // ExecutedAt returns execution time of this operation.
 func (o *Add) ExecutedAt() *time.Ticket {
 	return o.executedAt
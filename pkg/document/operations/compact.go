@@ -0,0 +1,142 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// CompactLog returns the minimal operations that build the state of the
+// given root. The elements and the text nodes removed before the given
+// safe point(`upTo`) are dropped, and the elements removed after it are kept
+// as tombstones to be merged with the concurrent changes.
+//
+// Replaying the returned operations on an empty root, whose root object is
+// created at the initial ticket, yields the same document as replaying the
+// full log.
+func CompactLog(root *crdt.Root, upTo *time.Ticket) ([]Operation, error) {
+	compacted := root.DeepCopy()
+	compacted.GarbageCollect(upTo)
+
+	var ops []Operation
+	if err := compactObject(compacted.Object(), &ops); err != nil {
+		return nil, err
+	}
+
+	return ops, nil
+}
+
+// compactObject appends the operations that build the members of the given
+// object.
+func compactObject(obj *crdt.Object, ops *[]Operation) error {
+	// NOTE: The overwritten values have the same key as the latest one, so
+	// the nodes of the same key are ordered by their creation time to keep
+	// the compacted log deterministic.
+	nodes := obj.RHTNodes()
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Key() != nodes[j].Key() {
+			return nodes[i].Key() < nodes[j].Key()
+		}
+		return nodes[j].Element().CreatedAt().After(nodes[i].Element().CreatedAt())
+	})
+
+	for _, node := range nodes {
+		elem := node.Element()
+		value, err := shallowCopy(elem)
+		if err != nil {
+			return err
+		}
+
+		*ops = append(*ops, NewSet(obj.CreatedAt(), node.Key(), value, elem.CreatedAt()))
+		if err := compactChildren(elem, ops); err != nil {
+			return err
+		}
+		if elem.RemovedAt() != nil {
+			*ops = append(*ops, NewRemove(obj.CreatedAt(), elem.CreatedAt(), elem.RemovedAt()))
+		}
+	}
+
+	return nil
+}
+
+// compactArray appends the operations that build the elements of the given
+// array.
+func compactArray(arr *crdt.Array, ops *[]Operation) error {
+	prevCreatedAt := time.InitialTicket
+	for _, node := range arr.RGANodes() {
+		elem := node.Element()
+		value, err := shallowCopy(elem)
+		if err != nil {
+			return err
+		}
+
+		*ops = append(*ops, NewAdd(arr.CreatedAt(), prevCreatedAt, value, elem.CreatedAt()))
+		if err := compactChildren(elem, ops); err != nil {
+			return err
+		}
+		if elem.RemovedAt() != nil {
+			*ops = append(*ops, NewRemove(arr.CreatedAt(), elem.CreatedAt(), elem.RemovedAt()))
+		}
+
+		prevCreatedAt = elem.CreatedAt()
+	}
+
+	return nil
+}
+
+// compactChildren appends the operations that build the children of the
+// given element if it is a container.
+func compactChildren(elem crdt.Element, ops *[]Operation) error {
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		return compactObject(elem, ops)
+	case *crdt.Array:
+		return compactArray(elem, ops)
+	}
+
+	return nil
+}
+
+// shallowCopy returns a copy of the given element without its children and
+// removal time. The children of containers are built by the separate
+// operations so that they can be registered in the root, and the removal is
+// built by Remove operation so that it can be collected by GC.
+func shallowCopy(elem crdt.Element) (crdt.Element, error) {
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		return crdt.NewObject(crdt.NewElementRHT(), elem.CreatedAt()), nil
+	case *crdt.Array:
+		return crdt.NewArray(crdt.NewRGATreeList(), elem.CreatedAt()), nil
+	case *crdt.Primitive:
+		primitive := elem.DeepCopy().(*crdt.Primitive)
+		primitive.SetRemovedAt(nil)
+		return primitive, nil
+	case *crdt.Counter:
+		counter := elem.DeepCopy().(*crdt.Counter)
+		counter.SetRemovedAt(nil)
+		return counter, nil
+	case *crdt.Text:
+		text := elem.DeepCopy().(*crdt.Text)
+		text.SetRemovedAt(nil)
+		return text, nil
+	}
+
+	return nil, ErrNotApplicableDataType
+}
@@ -0,0 +1,346 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// differ computes the operations between two versions of a document.
+type differ struct {
+	a, b *crdt.Root
+	ops  []Operation
+
+	// lamport and delimiter are the clock of the tickets issued for the
+	// operations that don't have their own tickets in `b`.
+	lamport   int64
+	delimiter uint32
+}
+
+// DiffRoots returns the operations that transform the given root `a` into
+// the given root `b`, where `b` is expected to be a later version of `a`,
+// such as the replica of the same document that has applied more changes.
+//
+// The elements are aligned by their creation times, not by their values.
+// The elements added in `b` are built by Set and Add with their own tickets
+// like CompactLog, the removed ones are removed by Remove, the counters are
+// increased by Increase, and the contents of the texts are changed by Edit,
+// Style and RemoveStyle. The operations that don't have their own tickets in
+// `b`, such as Edit, are stamped with the tickets after the latest one of
+// both roots and the initial actor, so SetActor should be called before they
+// are sent as a change.
+//
+// The moves of elements are not reflected. It returns an error wrapping
+// ErrNotDiffable if `b` has not seen an element of `a`, for example if they
+// have applied concurrent changes.
+func DiffRoots(a, b *crdt.Root) ([]Operation, error) {
+	if a.Object().CreatedAt().Compare(b.Object().CreatedAt()) != 0 {
+		return nil, fmt.Errorf("diff roots: %w", ErrNotDiffable)
+	}
+
+	d := &differ{
+		a:       a,
+		b:       b,
+		lamport: latestLamportOf(a, b) + 1,
+	}
+	if err := d.diffObject(a.Object(), b.Object()); err != nil {
+		return nil, err
+	}
+
+	return d.ops, nil
+}
+
+// issueTicket issues a new ticket after the tickets of both roots.
+func (d *differ) issueTicket() *time.Ticket {
+	d.delimiter++
+	return time.NewTicket(d.lamport, d.delimiter, time.InitialActorID)
+}
+
+// removedAtOf returns the removal time of the given element in `b`, or a
+// new ticket if `b` doesn't have the element anymore.
+func (d *differ) removedAtOf(createdAt *time.Ticket) *time.Ticket {
+	if elem := d.b.FindByCreatedAt(createdAt); elem != nil && elem.RemovedAt() != nil {
+		return elem.RemovedAt()
+	}
+	return d.issueTicket()
+}
+
+// diffElement appends the operations that transform the given element of
+// `a` into the element of `b` created at the same time.
+func (d *differ) diffElement(a, b crdt.Element) error {
+	switch a := a.(type) {
+	case *crdt.Object:
+		return d.diffObject(a, b.(*crdt.Object))
+	case *crdt.Array:
+		return d.diffArray(a, b.(*crdt.Array))
+	case *crdt.Text:
+		return d.diffText(a, b.(*crdt.Text))
+	case *crdt.Counter:
+		return d.diffCounter(a, b.(*crdt.Counter))
+	}
+
+	// NOTE: The other elements, such as primitives, are immutable.
+	return nil
+}
+
+// diffObject appends the operations that transform the members of the
+// given object of `a` into the ones of `b`.
+func (d *differ) diffObject(a, b *crdt.Object) error {
+	aMembers, bMembers := a.Members(), b.Members()
+
+	for _, key := range sortedKeys(bMembers) {
+		bElem := bMembers[key]
+		aElem, ok := aMembers[key]
+		if ok && aElem.CreatedAt().Compare(bElem.CreatedAt()) == 0 {
+			if err := d.diffElement(aElem, bElem); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// NOTE: The latest element of the key wins, so the element of `b`
+		// can't replace a later one of `a`.
+		if ok && aElem.CreatedAt().After(bElem.CreatedAt()) {
+			return fmt.Errorf("diff %s: %w", key, ErrNotDiffable)
+		}
+
+		value, err := shallowCopy(bElem)
+		if err != nil {
+			return err
+		}
+		d.ops = append(d.ops, NewSet(a.CreatedAt(), key, value, bElem.CreatedAt()))
+		if err := compactChildren(bElem, &d.ops); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range sortedKeys(aMembers) {
+		if _, ok := bMembers[key]; ok {
+			continue
+		}
+
+		createdAt := aMembers[key].CreatedAt()
+		d.ops = append(d.ops, NewRemove(a.CreatedAt(), createdAt, d.removedAtOf(createdAt)))
+	}
+
+	return nil
+}
+
+// diffArray appends the operations that transform the elements of the given
+// array of `a` into the ones of `b`.
+func (d *differ) diffArray(a, b *crdt.Array) error {
+	aNodes := make(map[string]crdt.Element)
+	for _, node := range a.RGANodes() {
+		aNodes[node.Element().CreatedAt().Key()] = node.Element()
+	}
+
+	bLive := make(map[string]bool)
+	prevCreatedAt := time.InitialTicket
+	for _, node := range b.RGANodes() {
+		bElem := node.Element()
+		aElem, ok := aNodes[bElem.CreatedAt().Key()]
+		if bElem.RemovedAt() == nil {
+			bLive[bElem.CreatedAt().Key()] = true
+		}
+
+		switch {
+		case ok && aElem.RemovedAt() != nil && bElem.RemovedAt() == nil:
+			return fmt.Errorf("diff array: %w", ErrNotDiffable)
+		case ok && bElem.RemovedAt() == nil:
+			if err := d.diffElement(aElem, bElem); err != nil {
+				return err
+			}
+		case !ok && bElem.RemovedAt() == nil:
+			value, err := shallowCopy(bElem)
+			if err != nil {
+				return err
+			}
+			d.ops = append(d.ops, NewAdd(a.CreatedAt(), prevCreatedAt, value, bElem.CreatedAt()))
+			if err := compactChildren(bElem, &d.ops); err != nil {
+				return err
+			}
+		case !ok:
+			// NOTE: The element added and removed after `a` is skipped.
+			continue
+		}
+
+		prevCreatedAt = bElem.CreatedAt()
+	}
+
+	for _, node := range a.RGANodes() {
+		createdAt := node.Element().CreatedAt()
+		if node.Element().RemovedAt() != nil || bLive[createdAt.Key()] {
+			continue
+		}
+		d.ops = append(d.ops, NewRemove(a.CreatedAt(), createdAt, d.removedAtOf(createdAt)))
+	}
+
+	return nil
+}
+
+// diffText appends the Edit, Style and RemoveStyle operations that transform the content
+// of the given text of `a` into the one of `b`. The positions of the
+// operations are created on a copy of `a` to which the preceding operations
+// have been applied.
+func (d *differ) diffText(a, b *crdt.Text) error {
+	text := a.DeepCopy().(*crdt.Text)
+
+	for _, change := range crdt.DiffText(a, b) {
+		if change.Type == crdt.TextStyleChange {
+			if err := d.diffStyle(a, text, change); err != nil {
+				return err
+			}
+			continue
+		}
+
+		fromPos, toPos := text.CreateRange(change.From, change.To)
+		ticket := d.issueTicket()
+		_, createdAtMapByActor, err := text.Edit(fromPos, toPos, nil, change.Content, change.Attributes, ticket)
+		if err != nil {
+			return err
+		}
+		d.ops = append(d.ops, NewEdit(
+			a.CreatedAt(),
+			fromPos,
+			toPos,
+			createdAtMapByActor,
+			change.Content,
+			change.Attributes,
+			ticket,
+		))
+	}
+
+	return nil
+}
+
+// diffStyle appends the Style operation of the changed attributes and the
+// RemoveStyle operation of the removed attributes of the given style change,
+// and applies them to the given copy of the text of `a`.
+func (d *differ) diffStyle(a, text *crdt.Text, change crdt.TextChange) error {
+	fromPos, toPos := text.CreateRange(change.From, change.To)
+
+	if len(change.Attributes) > 0 {
+		ticket := d.issueTicket()
+		createdAtMapByActor, err := text.Style(fromPos, toPos, nil, change.Attributes, ticket)
+		if err != nil {
+			return err
+		}
+		d.ops = append(d.ops, NewStyle(
+			a.CreatedAt(),
+			fromPos,
+			toPos,
+			createdAtMapByActor,
+			change.Attributes,
+			ticket,
+		))
+	}
+
+	if len(change.RemovedAttributes) > 0 {
+		ticket := d.issueTicket()
+		createdAtMapByActor, err := text.RemoveStyle(fromPos, toPos, nil, change.RemovedAttributes, ticket)
+		if err != nil {
+			return err
+		}
+		d.ops = append(d.ops, NewRemoveStyle(
+			a.CreatedAt(),
+			fromPos,
+			toPos,
+			createdAtMapByActor,
+			change.RemovedAttributes,
+			ticket,
+		))
+	}
+
+	return nil
+}
+
+// diffCounter appends the Increase operation by the difference of the
+// values of the given counters.
+func (d *differ) diffCounter(a, b *crdt.Counter) error {
+	var delta interface{}
+	switch aValue := a.Value().(type) {
+	case int32:
+		if aValue == b.Value().(int32) {
+			return nil
+		}
+		delta = b.Value().(int32) - aValue
+	case int64:
+		if aValue == b.Value().(int64) {
+			return nil
+		}
+		delta = b.Value().(int64) - aValue
+	case float64:
+		if aValue == b.Value().(float64) {
+			return nil
+		}
+		delta = b.Value().(float64) - aValue
+	default:
+		return ErrNotApplicableDataType
+	}
+
+	ticket := d.issueTicket()
+	d.ops = append(d.ops, NewIncrease(a.CreatedAt(), crdt.NewPrimitive(delta, ticket), ticket))
+	return nil
+}
+
+// latestLamportOf returns the largest Lamport timestamp of the given roots,
+// including the ones of the tickets of the elements and the text nodes,
+// because the root created from a snapshot starts with an empty version.
+func latestLamportOf(roots ...*crdt.Root) int64 {
+	var lamport int64
+	observe := func(tickets ...*time.Ticket) {
+		for _, ticket := range tickets {
+			if ticket != nil && ticket.Lamport() > lamport {
+				lamport = ticket.Lamport()
+			}
+		}
+	}
+
+	for _, root := range roots {
+		for _, l := range root.Version() {
+			if l > lamport {
+				lamport = l
+			}
+		}
+		root.Object().Descendants(func(elem crdt.Element, _ crdt.Container) bool {
+			observe(elem.CreatedAt(), elem.MovedAt(), elem.RemovedAt())
+			if text, ok := elem.(*crdt.Text); ok {
+				for _, node := range text.Nodes() {
+					observe(node.ID().CreatedAt(), node.RemovedAt())
+				}
+			}
+			return false
+		})
+	}
+
+	return lamport
+}
+
+// sortedKeys returns the keys of the given members in order, so that the
+// operations are deterministic.
+func sortedKeys(members map[string]crdt.Element) []string {
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -17,6 +17,9 @@
 package operations
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -41,6 +44,10 @@ type Edit struct {
 	// content is the content of text added when editing.
 	content string
 
+	// embed is the payload of the embedded inline object added when editing.
+	// It is nil if the content is a string.
+	embed map[string]string
+
 	// attributes represents the text style.
 	attributes map[string]string
 
@@ -69,13 +76,47 @@ func NewEdit(
 	}
 }
 
+// NewEditEmbed creates a new instance of Edit that adds the embedded inline
+// object of the given payload instead of a string.
+func NewEditEmbed(
+	parentCreatedAt *time.Ticket,
+	from *crdt.RGATreeSplitNodePos,
+	to *crdt.RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	embed map[string]string,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) *Edit {
+	if embed == nil {
+		embed = make(map[string]string)
+	}
+
+	return &Edit{
+		parentCreatedAt:           parentCreatedAt,
+		from:                      from,
+		to:                        to,
+		latestCreatedAtMapByActor: latestCreatedAtMapByActor,
+		embed:                     embed,
+		attributes:                attributes,
+		executedAt:                executedAt,
+	}
+}
+
 // Execute executes this operation on the given document(`root`).
-func (e *Edit) Execute(root *crdt.Root) error {
+func (e *Edit) Execute(_ context.Context, root *crdt.Root) error {
 	parent := root.FindByCreatedAt(e.parentCreatedAt)
 
 	switch obj := parent.(type) {
 	case *crdt.Text:
-		obj.Edit(e.from, e.to, e.latestCreatedAtMapByActor, e.content, e.attributes, e.executedAt)
+		var err error
+		if e.embed != nil {
+			_, _, err = obj.EditEmbed(e.from, e.to, e.latestCreatedAtMapByActor, e.embed, e.attributes, e.executedAt)
+		} else {
+			_, _, err = obj.Edit(e.from, e.to, e.latestCreatedAtMapByActor, e.content, e.attributes, e.executedAt)
+		}
+		if err != nil {
+			return err
+		}
 		if !e.from.Equal(e.to) {
 			root.RegisterTextElementWithGarbage(obj)
 		}
@@ -86,6 +127,23 @@ func (e *Edit) Execute(root *crdt.Root) error {
 	return nil
 }
 
+// Validate checks whether this operation is well-formed and can be executed
+// on the given document(`root`).
+func (e *Edit) Validate(root *crdt.Root) error {
+	parent, err := findParent(root, "edit", e.parentCreatedAt, e.executedAt)
+	if err != nil {
+		return err
+	}
+	if _, ok := parent.(*crdt.Text); !ok {
+		return fmt.Errorf("edit: %w", ErrNotApplicableDataType)
+	}
+	if err := root.Policy().CheckEditContent(e.content); err != nil {
+		return fmt.Errorf("edit: %w", err)
+	}
+
+	return validateRange("edit", e.from, e.to)
+}
+
 // From returns the start point of the editing range.
 func (e *Edit) From() *crdt.RGATreeSplitNodePos {
 	return e.from
@@ -111,11 +169,31 @@ func (e *Edit) ParentCreatedAt() *time.Ticket {
 	return e.parentCreatedAt
 }
 
+// Dependencies returns the creation times of the Text and the nodes of the
+// range to edit.
+func (e *Edit) Dependencies() []*time.Ticket {
+	return dependenciesOf(append([]*time.Ticket{e.parentCreatedAt}, rangeCreatedAts(e.from, e.to)...)...)
+}
+
 // Content returns the content of Edit.
 func (e *Edit) Content() string {
 	return e.content
 }
 
+// Embed returns the payload of the embedded inline object of Edit. It is nil
+// if the content of Edit is a string.
+func (e *Edit) Embed() map[string]string {
+	return e.embed
+}
+
+// AppendContent appends the given content to the content of this Edit. It
+// is for coalescing the keystrokes into the Edit of the first one before it
+// is sent as a change, so the content inserted by this Edit should have been
+// extended by Text.CoalesceInsert as well.
+func (e *Edit) AppendContent(content string) {
+	e.content += content
+}
+
 // Attributes returns the attributes of this Edit.
 func (e *Edit) Attributes() map[string]string {
 	return e.attributes
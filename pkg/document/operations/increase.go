@@ -17,6 +17,10 @@
 package operations
 
 import (
+	"context"
+	"fmt"
+	"math"
+
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -43,7 +47,7 @@ func NewIncrease(
 }
 
 // Execute executes this operation on the given document(`root`).
-func (o *Increase) Execute(root *crdt.Root) error {
+func (o *Increase) Execute(_ context.Context, root *crdt.Root) error {
 	parent := root.FindByCreatedAt(o.parentCreatedAt)
 	cnt, ok := parent.(*crdt.Counter)
 	if !ok {
@@ -56,16 +60,70 @@ func (o *Increase) Execute(root *crdt.Root) error {
 	return nil
 }
 
+// Validate checks whether this operation is well-formed and can be executed
+// on the given document(`root`).
+func (o *Increase) Validate(root *crdt.Root) error {
+	parent, err := findParent(root, "increase", o.parentCreatedAt, o.executedAt)
+	if err != nil {
+		return err
+	}
+	if _, ok := parent.(*crdt.Counter); !ok {
+		return fmt.Errorf("increase: %w", ErrNotApplicableDataType)
+	}
+	if value, ok := o.value.(*crdt.Primitive); !ok || !value.IsNumericType() {
+		return fmt.Errorf("increase: non-numeric value: %w", ErrInvalidOperation)
+	}
+
+	return nil
+}
+
 // Value return the value of this operation.
 func (o *Increase) Value() crdt.Element {
 	return o.value
 }
 
+// Invert returns the operation that reverts this operation, which increases
+// the same Counter by the negated value, so that the undo stack can revert
+// the increase even after other increases. The inverse is executed at the
+// given time, which must be after this operation. It returns an error if the
+// value can't be negated.
+func (o *Increase) Invert(executedAt *time.Ticket) (Operation, error) {
+	value, ok := o.value.(*crdt.Primitive)
+	if !ok {
+		return nil, fmt.Errorf("invert increase: %T: %w", o.value, ErrNotInvertible)
+	}
+
+	var negated interface{}
+	switch val := value.Value().(type) {
+	case int32:
+		if val == math.MinInt32 {
+			return nil, fmt.Errorf("invert increase %d: overflow: %w", val, ErrNotInvertible)
+		}
+		negated = -val
+	case int64:
+		if val == math.MinInt64 {
+			return nil, fmt.Errorf("invert increase %d: overflow: %w", val, ErrNotInvertible)
+		}
+		negated = -val
+	case float64:
+		negated = -val
+	default:
+		return nil, fmt.Errorf("invert increase: %T: %w", val, ErrNotInvertible)
+	}
+
+	return NewIncrease(o.parentCreatedAt, crdt.NewPrimitive(negated, executedAt), executedAt), nil
+}
+
 // ParentCreatedAt returns the creation time of Counter.
 func (o *Increase) ParentCreatedAt() *time.Ticket {
 	return o.parentCreatedAt
 }
 
+// Dependencies returns the creation time of the Counter.
+func (o *Increase) Dependencies() []*time.Ticket {
+	return dependenciesOf(o.parentCreatedAt)
+}
+
 // ExecutedAt returns execution time of this operation.
 func (o *Increase) ExecutedAt() *time.Ticket {
 	return o.executedAt
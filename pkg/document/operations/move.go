@@ -17,6 +17,9 @@
 package operations
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -52,7 +55,7 @@ func NewMove(
 }
 
 // Execute executes this operation on the given document(`root`).
-func (o *Move) Execute(root *crdt.Root) error {
+func (o *Move) Execute(_ context.Context, root *crdt.Root) error {
 	parent := root.FindByCreatedAt(o.parentCreatedAt)
 
 	obj, ok := parent.(*crdt.Array)
@@ -65,6 +68,23 @@ func (o *Move) Execute(root *crdt.Root) error {
 	return nil
 }
 
+// Validate checks whether this operation is well-formed and can be executed
+// on the given document(`root`).
+func (o *Move) Validate(root *crdt.Root) error {
+	parent, err := findParent(root, "move", o.parentCreatedAt, o.executedAt)
+	if err != nil {
+		return err
+	}
+	if _, ok := parent.(*crdt.Array); !ok {
+		return fmt.Errorf("move: %w", ErrNotApplicableDataType)
+	}
+	if o.prevCreatedAt == nil || o.createdAt == nil {
+		return fmt.Errorf("move: missing ticket: %w", ErrInvalidOperation)
+	}
+
+	return nil
+}
+
 // CreatedAt returns the creation time of the target element.
 func (o *Move) CreatedAt() *time.Ticket {
 	return o.createdAt
@@ -75,6 +95,12 @@ func (o *Move) ParentCreatedAt() *time.Ticket {
 	return o.parentCreatedAt
 }
 
+// Dependencies returns the creation times of the parent Array, the previous
+// element to move after and the element to move.
+func (o *Move) Dependencies() []*time.Ticket {
+	return dependenciesOf(o.parentCreatedAt, o.prevCreatedAt, o.createdAt)
+}
+
 // ExecutedAt returns execution time of this operation.
 func (o *Move) ExecutedAt() *time.Ticket {
 	return o.executedAt
@@ -19,7 +19,9 @@
 package operations
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
@@ -29,12 +31,31 @@ var (
 	// ErrNotApplicableDataType occurs when attempting to execute an operation
 	// on a data type that cannot be executed.
 	ErrNotApplicableDataType = errors.New("not applicable datatype")
+
+	// ErrInvalidOperation occurs when the operation is malformed, such as
+	// missing tickets or the parent that can't be found.
+	ErrInvalidOperation = errors.New("invalid operation")
+
+	// ErrNotInvertible occurs when the inverse of the operation can't be
+	// created, such as restoring a container.
+	ErrNotInvertible = errors.New("not invertible")
+
+	// ErrNotDiffable occurs when the operations between two versions of a
+	// document can't be computed, such as when the later version has not
+	// seen an element of the earlier one.
+	ErrNotDiffable = errors.New("not diffable")
 )
 
 // Operation represents an operation to be executed on a document.
 type Operation interface {
-	// Execute executes this operation on the given document(`root`).
-	Execute(root *crdt.Root) error
+	// Execute executes this operation on the given document(`root`). The
+	// given context carries the deadline and the request-scoped values of the
+	// caller.
+	Execute(ctx context.Context, root *crdt.Root) error
+
+	// Validate checks whether this operation is well-formed and can be
+	// executed on the given document(`root`) without mutating it.
+	Validate(root *crdt.Root) error
 
 	// ExecutedAt returns execution time of this operation.
 	ExecutedAt() *time.Ticket
@@ -45,4 +66,68 @@ type Operation interface {
 	// ParentCreatedAt returns the creation time of the target element to
 	// execute the operation.
 	ParentCreatedAt() *time.Ticket
+
+	// Dependencies returns the creation times of the elements and the text
+	// nodes that this operation causally depends on, so that the operation
+	// can be buffered until all of them have been applied.
+	Dependencies() []*time.Ticket
+}
+
+// findParent returns the parent of the operation after checking the tickets
+// of the operation.
+func findParent(
+	root *crdt.Root,
+	name string,
+	parentCreatedAt *time.Ticket,
+	executedAt *time.Ticket,
+) (crdt.Element, error) {
+	if parentCreatedAt == nil || executedAt == nil {
+		return nil, fmt.Errorf("%s: missing ticket: %w", name, ErrInvalidOperation)
+	}
+
+	parent := root.FindByCreatedAt(parentCreatedAt)
+	if parent == nil {
+		return nil, fmt.Errorf("%s: parent %s not found: %w", name, parentCreatedAt.Key(), ErrInvalidOperation)
+	}
+
+	return parent, nil
+}
+
+// dependenciesOf returns the given tickets without the nil and the
+// duplicated ones, keeping the order.
+func dependenciesOf(tickets ...*time.Ticket) []*time.Ticket {
+	var deps []*time.Ticket
+	seen := make(map[string]bool)
+	for _, ticket := range tickets {
+		if ticket == nil || seen[ticket.Key()] {
+			continue
+		}
+		seen[ticket.Key()] = true
+		deps = append(deps, ticket)
+	}
+
+	return deps
+}
+
+// rangeCreatedAts returns the creation times of the nodes of the given range.
+func rangeCreatedAts(from, to *crdt.RGATreeSplitNodePos) []*time.Ticket {
+	var tickets []*time.Ticket
+	for _, pos := range []*crdt.RGATreeSplitNodePos{from, to} {
+		if pos != nil && pos.ID() != nil {
+			tickets = append(tickets, pos.ID().CreatedAt())
+		}
+	}
+
+	return tickets
+}
+
+// validateRange checks the given range of a text.
+func validateRange(name string, from, to *crdt.RGATreeSplitNodePos) error {
+	for _, pos := range []*crdt.RGATreeSplitNodePos{from, to} {
+		if pos == nil || pos.ID() == nil || pos.ID().CreatedAt() == nil || pos.RelativeOffset() < 0 {
+			return fmt.Errorf("%s: malformed range: %w", name, ErrInvalidOperation)
+		}
+	}
+
+	return nil
 }
@@ -17,6 +17,9 @@
 package operations
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -48,7 +51,7 @@ func NewRemove(
 }
 
 // Execute executes this operation on the given document(`root`).
-func (o *Remove) Execute(root *crdt.Root) error {
+func (o *Remove) Execute(_ context.Context, root *crdt.Root) error {
 	parentElem := root.FindByCreatedAt(o.parentCreatedAt)
 
 	switch parent := parentElem.(type) {
@@ -63,11 +66,34 @@ func (o *Remove) Execute(root *crdt.Root) error {
 	return nil
 }
 
+// Validate checks whether this operation is well-formed and can be executed
+// on the given document(`root`).
+func (o *Remove) Validate(root *crdt.Root) error {
+	parent, err := findParent(root, "remove", o.parentCreatedAt, o.executedAt)
+	if err != nil {
+		return err
+	}
+	if _, ok := parent.(crdt.Container); !ok {
+		return fmt.Errorf("remove: %w", ErrNotApplicableDataType)
+	}
+	if o.createdAt == nil {
+		return fmt.Errorf("remove: missing ticket: %w", ErrInvalidOperation)
+	}
+
+	return nil
+}
+
 // ParentCreatedAt returns the creation time of the Container.
 func (o *Remove) ParentCreatedAt() *time.Ticket {
 	return o.parentCreatedAt
 }
 
+// Dependencies returns the creation times of the parent and the element to
+// remove.
+func (o *Remove) Dependencies() []*time.Ticket {
+	return dependenciesOf(o.parentCreatedAt, o.createdAt)
+}
+
 // ExecutedAt returns execution time of this operation.
 func (o *Remove) ExecutedAt() *time.Ticket {
 	return o.executedAt
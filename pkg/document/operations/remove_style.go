@@ -0,0 +1,144 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// RemoveStyle is an operation removes the attributes of the given range of
+// Text.
+type RemoveStyle struct {
+	// parentCreatedAt is the creation time of the Text that executes
+	// RemoveStyle.
+	parentCreatedAt *time.Ticket
+
+	// from is the starting point of the range to remove the style from.
+	from *crdt.RGATreeSplitNodePos
+
+	// to is the end point of the range to remove the style from.
+	to *crdt.RGATreeSplitNodePos
+
+	// latestCreatedAtMapByActor is a map that stores the latest creation time
+	// by actor for the nodes included in the range.
+	latestCreatedAtMapByActor map[string]*time.Ticket
+
+	// attributesToRemove is the keys of the attributes to remove.
+	attributesToRemove []string
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewRemoveStyle creates a new instance of RemoveStyle.
+func NewRemoveStyle(
+	parentCreatedAt *time.Ticket,
+	from *crdt.RGATreeSplitNodePos,
+	to *crdt.RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attributesToRemove []string,
+	executedAt *time.Ticket,
+) *RemoveStyle {
+	return &RemoveStyle{
+		parentCreatedAt:           parentCreatedAt,
+		from:                      from,
+		to:                        to,
+		latestCreatedAtMapByActor: latestCreatedAtMapByActor,
+		attributesToRemove:        attributesToRemove,
+		executedAt:                executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (e *RemoveStyle) Execute(_ context.Context, root *crdt.Root) error {
+	parent := root.FindByCreatedAt(e.parentCreatedAt)
+	obj, ok := parent.(*crdt.Text)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	if err := obj.ApplyRemoveStyle(
+		e.from,
+		e.to,
+		e.latestCreatedAtMapByActor,
+		e.attributesToRemove,
+		e.executedAt,
+	); err != nil {
+		return fmt.Errorf("remove style: %w", err)
+	}
+	return nil
+}
+
+// Validate checks whether this operation is well-formed and can be executed
+// on the given document(`root`).
+func (e *RemoveStyle) Validate(root *crdt.Root) error {
+	parent, err := findParent(root, "remove style", e.parentCreatedAt, e.executedAt)
+	if err != nil {
+		return err
+	}
+	if _, ok := parent.(*crdt.Text); !ok {
+		return fmt.Errorf("remove style: %w", ErrNotApplicableDataType)
+	}
+
+	return validateRange("remove style", e.from, e.to)
+}
+
+// From returns the start point of the range.
+func (e *RemoveStyle) From() *crdt.RGATreeSplitNodePos {
+	return e.from
+}
+
+// To returns the end point of the range.
+func (e *RemoveStyle) To() *crdt.RGATreeSplitNodePos {
+	return e.to
+}
+
+// ExecutedAt returns execution time of this operation.
+func (e *RemoveStyle) ExecutedAt() *time.Ticket {
+	return e.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (e *RemoveStyle) SetActor(actorID *time.ActorID) {
+	e.executedAt = e.executedAt.SetActorID(actorID)
+}
+
+// ParentCreatedAt returns the creation time of the Text.
+func (e *RemoveStyle) ParentCreatedAt() *time.Ticket {
+	return e.parentCreatedAt
+}
+
+// Dependencies returns the creation times of the Text and the nodes of the
+// range to remove the style from.
+func (e *RemoveStyle) Dependencies() []*time.Ticket {
+	return dependenciesOf(append([]*time.Ticket{e.parentCreatedAt}, rangeCreatedAts(e.from, e.to)...)...)
+}
+
+// CreatedAtMapByActor returns the map that stores the latest creation time
+// by actor for the nodes included in the range.
+func (e *RemoveStyle) CreatedAtMapByActor() map[string]*time.Ticket {
+	return e.latestCreatedAtMapByActor
+}
+
+// AttributesToRemove returns the keys of the attributes to remove.
+func (e *RemoveStyle) AttributesToRemove() []string {
+	return e.attributesToRemove
+}
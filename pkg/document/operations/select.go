@@ -17,6 +17,9 @@
 package operations
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -52,12 +55,13 @@ func NewSelect(
 }
 
 // Execute executes this operation on the given document(`root`).
-func (s *Select) Execute(root *crdt.Root) error {
+func (s *Select) Execute(_ context.Context, root *crdt.Root) error {
 	parent := root.FindByCreatedAt(s.parentCreatedAt)
 
 	switch obj := parent.(type) {
 	case *crdt.Text:
 		obj.Select(s.from, s.to, s.executedAt)
+		root.RegisterTextWithSelection(obj)
 	default:
 		return ErrNotApplicableDataType
 	}
@@ -65,6 +69,20 @@ func (s *Select) Execute(root *crdt.Root) error {
 	return nil
 }
 
+// Validate checks whether this operation is well-formed and can be executed
+// on the given document(`root`).
+func (s *Select) Validate(root *crdt.Root) error {
+	parent, err := findParent(root, "select", s.parentCreatedAt, s.executedAt)
+	if err != nil {
+		return err
+	}
+	if _, ok := parent.(*crdt.Text); !ok {
+		return fmt.Errorf("select: %w", ErrNotApplicableDataType)
+	}
+
+	return validateRange("select", s.from, s.to)
+}
+
 // From returns the start point of the selection.
 func (s *Select) From() *crdt.RGATreeSplitNodePos {
 	return s.from
@@ -89,3 +107,9 @@ func (s *Select) SetActor(actorID *time.ActorID) {
 func (s *Select) ParentCreatedAt() *time.Ticket {
 	return s.parentCreatedAt
 }
+
+// Dependencies returns the creation times of the Text and the nodes of the
+// range to select.
+func (s *Select) Dependencies() []*time.Ticket {
+	return dependenciesOf(append([]*time.Ticket{s.parentCreatedAt}, rangeCreatedAts(s.from, s.to)...)...)
+}
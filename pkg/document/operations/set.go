@@ -17,6 +17,9 @@
 package operations
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -53,7 +56,7 @@ func NewSet(
 }
 
 // Execute executes this operation on the given document(`root`).
-func (o *Set) Execute(root *crdt.Root) error {
+func (o *Set) Execute(_ context.Context, root *crdt.Root) error {
 	parent := root.FindByCreatedAt(o.parentCreatedAt)
 
 	obj, ok := parent.(*crdt.Object)
@@ -70,11 +73,74 @@ func (o *Set) Execute(root *crdt.Root) error {
 	return nil
 }
 
+// Validate checks whether this operation is well-formed and can be executed
+// on the given document(`root`).
+func (o *Set) Validate(root *crdt.Root) error {
+	parent, err := findParent(root, "set", o.parentCreatedAt, o.executedAt)
+	if err != nil {
+		return err
+	}
+	if _, ok := parent.(*crdt.Object); !ok {
+		return fmt.Errorf("set: %w", ErrNotApplicableDataType)
+	}
+	if o.value == nil || o.value.CreatedAt() == nil {
+		return fmt.Errorf("set: missing value: %w", ErrInvalidOperation)
+	}
+	if err := crdt.ValidateElementType(o.value); err != nil {
+		return fmt.Errorf("set: %w", err)
+	}
+
+	return nil
+}
+
+// Invert returns the operation that reverts this operation, which restores
+// the current value of the key: a Set of the value, or a Remove of the value
+// of this operation if the key has no value. It reads the current value, so
+// it must be called before this operation is executed. The inverse is
+// executed at the given time, which must be after this operation, because
+// the latest value of the key wins. Only the primitives and the counters can
+// be restored.
+func (o *Set) Invert(root *crdt.Root, executedAt *time.Ticket) (Operation, error) {
+	parent, err := findParent(root, "invert set", o.parentCreatedAt, o.executedAt)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := parent.(*crdt.Object)
+	if !ok {
+		return nil, fmt.Errorf("invert set: %w", ErrNotApplicableDataType)
+	}
+
+	prev := obj.Get(o.key)
+	if prev != nil && prev.CreatedAt().Compare(o.value.CreatedAt()) == 0 {
+		return nil, fmt.Errorf("invert set %s: already executed: %w", o.key, ErrNotInvertible)
+	}
+	if prev == nil || prev.RemovedAt() != nil {
+		return NewRemove(o.parentCreatedAt, o.value.CreatedAt(), executedAt), nil
+	}
+
+	var value crdt.Element
+	switch prev := prev.(type) {
+	case *crdt.Primitive:
+		value = crdt.NewPrimitive(prev.Value(), executedAt)
+	case *crdt.Counter:
+		value = crdt.NewCounter(prev.ValueType(), prev.Value(), executedAt)
+	default:
+		return nil, fmt.Errorf("invert set %s: %T: %w", o.key, prev, ErrNotInvertible)
+	}
+
+	return NewSet(o.parentCreatedAt, o.key, value, executedAt), nil
+}
+
 // ParentCreatedAt returns the creation time of the Object.
 func (o *Set) ParentCreatedAt() *time.Ticket {
 	return o.parentCreatedAt
 }
 
+// Dependencies returns the creation time of the parent Object.
+func (o *Set) Dependencies() []*time.Ticket {
+	return dependenciesOf(o.parentCreatedAt)
+}
+
 // ExecutedAt returns execution time of this operation.
 func (o *Set) ExecutedAt() *time.Ticket {
 	return o.executedAt
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// SetTree represents an operation that stores the pre-built subtree under
+// the given key in the Object. Unlike Set, which registers only the given
+// element, SetTree registers every descendant of the subtree in the root, so
+// a nested document can be seeded with a single operation.
+type SetTree struct {
+	// parentCreatedAt is the creation time of the Object that executes
+	// SetTree.
+	parentCreatedAt *time.Ticket
+
+	// key corresponds to the key of the object to set the subtree.
+	key string
+
+	// value is the root element of the subtree.
+	value crdt.Element
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewSetTree creates a new instance of SetTree.
+func NewSetTree(
+	parentCreatedAt *time.Ticket,
+	key string,
+	value crdt.Element,
+	executedAt *time.Ticket,
+) *SetTree {
+	return &SetTree{
+		key:             key,
+		value:           value,
+		parentCreatedAt: parentCreatedAt,
+		executedAt:      executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (o *SetTree) Execute(_ context.Context, root *crdt.Root) error {
+	parent := root.FindByCreatedAt(o.parentCreatedAt)
+
+	obj, ok := parent.(*crdt.Object)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	value := o.value.DeepCopy()
+	removed := obj.Set(o.key, value)
+	root.RegisterElement(value)
+	if container, ok := value.(crdt.Container); ok {
+		container.Descendants(func(elem crdt.Element, parent crdt.Container) bool {
+			root.RegisterElement(elem)
+			if elem.RemovedAt() != nil {
+				root.RegisterRemovedElementPair(parent, elem)
+			}
+			return false
+		})
+	}
+	if removed != nil {
+		root.RegisterRemovedElementPair(obj, removed)
+	}
+	return nil
+}
+
+// Validate checks whether this operation is well-formed and can be executed
+// on the given document(`root`). Every element of the subtree must have its
+// creation time.
+func (o *SetTree) Validate(root *crdt.Root) error {
+	parent, err := findParent(root, "set tree", o.parentCreatedAt, o.executedAt)
+	if err != nil {
+		return err
+	}
+	if _, ok := parent.(*crdt.Object); !ok {
+		return fmt.Errorf("set tree: %w", ErrNotApplicableDataType)
+	}
+	if o.value == nil || o.value.CreatedAt() == nil {
+		return fmt.Errorf("set tree: missing value: %w", ErrInvalidOperation)
+	}
+	if err := crdt.ValidateElementType(o.value); err != nil {
+		return fmt.Errorf("set tree: %w", err)
+	}
+
+	if container, ok := o.value.(crdt.Container); ok {
+		container.Descendants(func(elem crdt.Element, _ crdt.Container) bool {
+			if elem.CreatedAt() == nil {
+				err = fmt.Errorf("set tree: missing descendant ticket: %w", ErrInvalidOperation)
+				return true
+			}
+			return false
+		})
+	}
+
+	return err
+}
+
+// ParentCreatedAt returns the creation time of the Object.
+func (o *SetTree) ParentCreatedAt() *time.Ticket {
+	return o.parentCreatedAt
+}
+
+// Dependencies returns the creation time of the parent Object.
+func (o *SetTree) Dependencies() []*time.Ticket {
+	return dependenciesOf(o.parentCreatedAt)
+}
+
+// ExecutedAt returns execution time of this operation.
+func (o *SetTree) ExecutedAt() *time.Ticket {
+	return o.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (o *SetTree) SetActor(actorID *time.ActorID) {
+	o.executedAt = o.executedAt.SetActorID(actorID)
+}
+
+// Key returns the key of this operation.
+func (o *SetTree) Key() string {
+	return o.key
+}
+
+// Value returns the root element of the subtree of this operation.
+func (o *SetTree) Value() crdt.Element {
+	return o.value
+}
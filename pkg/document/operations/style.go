@@ -17,6 +17,9 @@
 package operations
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -32,6 +35,10 @@ type Style struct {
 	// to is the end point of the range to apply the style to.
 	to *crdt.RGATreeSplitNodePos
 
+	// latestCreatedAtMapByActor is a map that stores the latest creation time
+	// by actor for the nodes included in the styling range.
+	latestCreatedAtMapByActor map[string]*time.Ticket
+
 	// attributes represents the text style.
 	attributes map[string]string
 
@@ -44,30 +51,48 @@ func NewStyle(
 	parentCreatedAt *time.Ticket,
 	from *crdt.RGATreeSplitNodePos,
 	to *crdt.RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
 	attributes map[string]string,
 	executedAt *time.Ticket,
 ) *Style {
 	return &Style{
-		parentCreatedAt: parentCreatedAt,
-		from:            from,
-		to:              to,
-		attributes:      attributes,
-		executedAt:      executedAt,
+		parentCreatedAt:           parentCreatedAt,
+		from:                      from,
+		to:                        to,
+		latestCreatedAtMapByActor: latestCreatedAtMapByActor,
+		attributes:                attributes,
+		executedAt:                executedAt,
 	}
 }
 
 // Execute executes this operation on the given document(`root`).
-func (e *Style) Execute(root *crdt.Root) error {
+func (e *Style) Execute(_ context.Context, root *crdt.Root) error {
 	parent := root.FindByCreatedAt(e.parentCreatedAt)
 	obj, ok := parent.(*crdt.Text)
 	if !ok {
 		return ErrNotApplicableDataType
 	}
 
-	obj.Style(e.from, e.to, e.attributes, e.executedAt)
+	if err := obj.ApplyStyle(e.from, e.to, e.latestCreatedAtMapByActor, e.attributes, e.executedAt); err != nil {
+		return fmt.Errorf("style: %w", err)
+	}
 	return nil
 }
 
+// Validate checks whether this operation is well-formed and can be executed
+// on the given document(`root`).
+func (e *Style) Validate(root *crdt.Root) error {
+	parent, err := findParent(root, "style", e.parentCreatedAt, e.executedAt)
+	if err != nil {
+		return err
+	}
+	if _, ok := parent.(*crdt.Text); !ok {
+		return fmt.Errorf("style: %w", ErrNotApplicableDataType)
+	}
+
+	return validateRange("style", e.from, e.to)
+}
+
 // From returns the start point of the editing range.
 func (e *Style) From() *crdt.RGATreeSplitNodePos {
 	return e.from
@@ -93,6 +118,18 @@ func (e *Style) ParentCreatedAt() *time.Ticket {
 	return e.parentCreatedAt
 }
 
+// Dependencies returns the creation times of the Text and the nodes of the
+// range to style.
+func (e *Style) Dependencies() []*time.Ticket {
+	return dependenciesOf(append([]*time.Ticket{e.parentCreatedAt}, rangeCreatedAts(e.from, e.to)...)...)
+}
+
+// CreatedAtMapByActor returns the map that stores the latest creation time
+// by actor for the nodes included in the styling range.
+func (e *Style) CreatedAtMapByActor() map[string]*time.Ticket {
+	return e.latestCreatedAtMapByActor
+}
+
 // Attributes returns the attributes of this operation.
 func (e *Style) Attributes() map[string]string {
 	return e.attributes
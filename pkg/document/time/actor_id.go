@@ -121,6 +121,10 @@ func (id *ActorID) Bytes() []byte {
 // The result will be 0 if id==other, -1 if id < other, and +1 if id > other.
 // If the receiver or argument is nil, it would panic at runtime.
 func (id *ActorID) Compare(other *ActorID) int {
+	if id == other {
+		return 0
+	}
+
 	return bytes.Compare(id.bytes[:], other.bytes[:])
 }
 
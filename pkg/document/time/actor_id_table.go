@@ -0,0 +1,88 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package time
+
+// ActorIDTable interns the actor IDs of tickets. The tickets decoded from a
+// snapshot hold their own copies of the actor IDs unless they are created
+// with the same table, so a document edited by many actors keeps as many
+// copies of the same actor IDs and their hexadecimal strings as tickets.
+// Interning makes the tickets of an actor share one ActorID, and gives each
+// actor a small index in the order it is interned.
+type ActorIDTable struct {
+	actorIDs     []*ActorID
+	indexByBytes map[[actorIDSize]byte]int
+}
+
+// NewActorIDTable creates a new instance of ActorIDTable.
+func NewActorIDTable() *ActorIDTable {
+	return &ActorIDTable{
+		indexByBytes: make(map[[actorIDSize]byte]int),
+	}
+}
+
+// Intern returns the ActorID of the table equal to the given one, adding
+// the given one to the table if there is no such ActorID.
+func (t *ActorIDTable) Intern(id *ActorID) *ActorID {
+	if index, ok := t.indexByBytes[id.bytes]; ok {
+		return t.actorIDs[index]
+	}
+
+	// NOTE: The shared actor IDs are preferred, so that the tickets created
+	// with the table share them with InitialTicket and MaxTicket.
+	switch id.bytes {
+	case InitialActorID.bytes:
+		id = InitialActorID
+	case MaxActorID.bytes:
+		id = MaxActorID
+	}
+
+	t.indexByBytes[id.bytes] = len(t.actorIDs)
+	t.actorIDs = append(t.actorIDs, id)
+	return id
+}
+
+// NewTicket creates a new instance of Ticket with the interned actor ID.
+func (t *ActorIDTable) NewTicket(lamport int64, delimiter uint32, actorID *ActorID) *Ticket {
+	return NewTicket(lamport, delimiter, t.Intern(actorID))
+}
+
+// Index returns the index of the given actor ID in the table, and whether
+// the actor ID has been interned.
+func (t *ActorIDTable) Index(id *ActorID) (int, bool) {
+	index, ok := t.indexByBytes[id.bytes]
+	return index, ok
+}
+
+// ActorID returns the actor ID of the given index, and whether the index is
+// in the table.
+func (t *ActorIDTable) ActorID(index int) (*ActorID, bool) {
+	if index < 0 || index >= len(t.actorIDs) {
+		return nil, false
+	}
+
+	return t.actorIDs[index], true
+}
+
+// ActorIDs returns the interned actor IDs in the order of their indexes.
+func (t *ActorIDTable) ActorIDs() []*ActorID {
+	return t.actorIDs
+}
+
+// Len returns the number of the interned actor IDs.
+func (t *ActorIDTable) Len() int {
+	return len(t.actorIDs)
+}
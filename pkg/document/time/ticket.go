@@ -50,9 +50,16 @@ var (
 // Ticket is a timestamp of the logical clock. Ticket is immutable.
 // It is created by change.ID.
 type Ticket struct {
-	lamport   int64
+	// lamport is the Lamport timestamp of the change that issues the
+	// ticket. It increases with every change of an actor.
+	lamport int64
+
+	// delimiter is the sequence of the ticket within the change. With
+	// lamport, it orders the tickets of an actor in the order they are
+	// issued, without any tie.
 	delimiter uint32
-	actorID   *ActorID
+
+	actorID *ActorID
 
 	// cachedKey is the cache of the string representation of the ticket.
 	cachedKey string
@@ -125,6 +132,12 @@ func (t *Ticket) After(other *Ticket) bool {
 
 // Compare returns an integer comparing two Ticket.
 // The result will be 0 if id==other, -1 if id < other, and +1 if id > other.
+// Tickets are ordered by Lamport timestamp, then by the bytes of actor ID,
+// then by delimiter, so that every merge point of the CRDTs resolves the
+// concurrent operations with the same Lamport timestamp in the same order.
+// The tickets of the same actor with the same Lamport timestamp are issued
+// by the same change, so they are ordered by delimiter, the sequence within
+// the change, and an actor's own operations never reorder.
 // If the receiver or argument is nil, it would panic at runtime.
 func (t *Ticket) Compare(other *Ticket) int {
 	if t.lamport > other.lamport {
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package time
+
+// TicketGenerator issues the tickets of the operations of a change, so that
+// the tickets can be made deterministic in tests, or be issued by other
+// clock strategies such as hybrid logical clocks. The tickets issued for an
+// actor must increase in the order they are issued.
+type TicketGenerator interface {
+	// Issue returns the ticket of the operation of the given delimiter in
+	// the change of the given Lamport timestamp and actor.
+	Issue(lamport int64, delimiter uint32, actorID *ActorID) *Ticket
+}
+
+// TicketGeneratorFunc is an adapter to use an ordinary function as
+// TicketGenerator.
+type TicketGeneratorFunc func(lamport int64, delimiter uint32, actorID *ActorID) *Ticket
+
+// Issue calls f(lamport, delimiter, actorID).
+func (f TicketGeneratorFunc) Issue(lamport int64, delimiter uint32, actorID *ActorID) *Ticket {
+	return f(lamport, delimiter, actorID)
+}
+
+// LamportTicketGenerator is the default TicketGenerator, which issues the
+// tickets of the Lamport timestamp of the change.
+type LamportTicketGenerator struct{}
+
+// Issue returns the ticket of the given Lamport timestamp, delimiter and
+// actor.
+func (LamportTicketGenerator) Issue(lamport int64, delimiter uint32, actorID *ActorID) *Ticket {
+	return NewTicket(lamport, delimiter, actorID)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -55,4 +56,31 @@ func TestTicket(t *testing.T) {
 
 		assert.False(t, before.After(before))
 	})
+
+	t.Run("tickets of an actor keep the issued order test", func(t *testing.T) {
+		actorA, _ := time.ActorIDFromHex("0000000000000000000000aa")
+		actorB, _ := time.ActorIDFromHex("000000000000000000000001")
+
+		// the changes of actorA with the Lamport timestamp synced to the
+		// changes of actorB, which has the smaller actor ID.
+		id := change.InitialID.SetActor(actorA)
+		var issued []*time.Ticket
+		for i := 0; i < 3; i++ {
+			id = id.Next()
+			for delimiter := uint32(1); delimiter <= 3; delimiter++ {
+				issued = append(issued, id.NewTimeTicket(delimiter))
+			}
+			id = id.SyncLamport(id.Lamport() + int64(i))
+		}
+
+		for i := 1; i < len(issued); i++ {
+			assert.True(t, issued[i].After(issued[i-1]), issued[i].Key())
+		}
+
+		// a concurrent ticket of another actor doesn't break the order.
+		concurrent := time.NewTicket(issued[0].Lamport(), 10, actorB)
+		assert.True(t, issued[1].After(issued[0]))
+		assert.True(t, issued[0].After(concurrent))
+		assert.True(t, issued[1].After(concurrent))
+	})
 }
@@ -381,6 +381,16 @@ func (t *Tree[V]) rotateRight(pivot *Node[V]) {
 	t.UpdateWeight(pivot)
 }
 
+// Last returns the last node of this Tree in the order of the index, or nil
+// if the tree is empty.
+func (t *Tree[V]) Last() *Node[V] {
+	if t.root == nil {
+		return nil
+	}
+
+	return t.rightmost()
+}
+
 func (t *Tree[V]) rightmost() *Node[V] {
 	node := t.root
 	for node.right != nil {
@@ -398,6 +408,61 @@ func (t *Tree[V]) Len() int {
 	return t.root.weight
 }
 
+// Height returns the height of this Tree. The height of an empty tree is 0.
+func (t *Tree[V]) Height() int {
+	return height(t.root)
+}
+
+// Size returns the number of nodes in this Tree.
+func (t *Tree[V]) Size() int {
+	size := 0
+	traverseInOrder(t.root, func(node *Node[V]) {
+		size++
+	})
+	return size
+}
+
+// Rebalance rebuilds this Tree into a balanced tree keeping the order of
+// the nodes. A splay tree can degrade into a list under sequential access
+// patterns such as appending, so it can be called to recover the height.
+func (t *Tree[V]) Rebalance() {
+	var nodes []*Node[V]
+	traverseInOrder(t.root, func(node *Node[V]) {
+		nodes = append(nodes, node)
+	})
+
+	t.root = t.buildBalanced(nodes, nil)
+}
+
+// buildBalanced builds a balanced subtree of the given nodes in order and
+// returns the root of the subtree.
+func (t *Tree[V]) buildBalanced(nodes []*Node[V], parent *Node[V]) *Node[V] {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	mid := len(nodes) / 2
+	node := nodes[mid]
+	node.parent = parent
+	node.left = t.buildBalanced(nodes[:mid], node)
+	node.right = t.buildBalanced(nodes[mid+1:], node)
+	t.UpdateWeight(node)
+
+	return node
+}
+
+func height[V Value](node *Node[V]) int {
+	if node == nil {
+		return 0
+	}
+
+	left, right := height(node.left), height(node.right)
+	if left > right {
+		return left + 1
+	}
+	return right + 1
+}
+
 func traverseInOrder[V Value](node *Node[V], callback func(node *Node[V])) {
 	if node == nil {
 		return
@@ -151,6 +151,36 @@ func TestSplayTree(t *testing.T) {
 		tree.Delete(node)
 		assert.Equal(t, -1, tree.IndexOf(node))
 	})
+
+	t.Run("rebalance test", func(t *testing.T) {
+		tree, nodes := makeSampleTree()
+		assert.Equal(t, 9, tree.Height())
+		assert.Equal(t, 9, tree.Size())
+
+		removeNodes(nodes, 3, 4)
+		tree.DeleteRange(nodes[2], nodes[5])
+		tree.Rebalance()
+		assert.Equal(t, 4, tree.Height())
+		assert.Equal(t, 9, tree.Size())
+		assert.Equal(t, 16, tree.Len())
+		assert.True(t, tree.CheckWeight())
+		assert.Equal(t, "ABBCCCDDDDEEEEEFFFFGGGHHI", tree.String())
+
+		for i, expected := range []int{0, 1, 3, 6, 6, 6, 10, 13, 15} {
+			assert.Equal(t, expected, tree.IndexOf(nodes[i]))
+		}
+	})
+
+	t.Run("last test", func(t *testing.T) {
+		tree := splay.NewTree[*stringValue](nil)
+		assert.Nil(t, tree.Last())
+
+		tree, nodes := makeSampleTree()
+		assert.Equal(t, nodes[8], tree.Last())
+
+		tree.Splay(nodes[0])
+		assert.Equal(t, nodes[8], tree.Last())
+	})
 }
 
 func makeSampleTree() (*splay.Tree[*stringValue], []*splay.Node[*stringValue]) {
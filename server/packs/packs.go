@@ -197,7 +197,7 @@ func BuildDocumentForServerSeq(
 		return nil, err
 	}
 
-	if err := doc.ApplyChangePack(change.NewPack(
+	if err := doc.ApplyChangePack(ctx, change.NewPack(
 		docInfo.Key,
 		change.InitialCheckpoint.NextServerSeq(serverSeq),
 		changes,
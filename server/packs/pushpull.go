@@ -134,7 +134,7 @@ func pullSnapshot(
 
 	// Apply changes that are in the request pack.
 	if reqPack.HasChanges() {
-		if err := doc.ApplyChangePack(change.NewPack(
+		if err := doc.ApplyChangePack(ctx, change.NewPack(
 			docInfo.Key,
 			doc.Checkpoint().NextServerSeq(docInfo.ServerSeq),
 			reqPack.Changes,
@@ -76,7 +76,7 @@ func storeSnapshot(
 	)
 	pack.MinSyncedTicket = minSyncedTicket
 
-	if err := doc.ApplyChangePack(pack); err != nil {
+	if err := doc.ApplyChangePack(ctx, pack); err != nil {
 		return err
 	}
 
@@ -0,0 +1,92 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func BenchmarkActorIDTable(b *testing.B) {
+	b.Run("built text test", func(b *testing.B) {
+		benchmarkActorIDTable(b, 1000, 100000, false)
+	})
+
+	b.Run("decoded snapshot test", func(b *testing.B) {
+		benchmarkActorIDTable(b, 1000, 100000, true)
+	})
+}
+
+// benchmarkActorIDTable measures the heap of a text with the given number of
+// nodes inserted by the given number of actors, built with a copy of the
+// actor ID for each ticket or decoded from a snapshot, whose tickets of an
+// actor share one actor ID.
+func benchmarkActorIDTable(b *testing.B, actors, nodes int, decode bool) {
+	build := func() *crdt.Object {
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), time.InitialTicket)
+		for i := 0; i < nodes; i++ {
+			actorID, err := time.ActorIDFromHex(fmt.Sprintf("%024x", i%actors+1))
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := text.Append("a", nil, time.NewTicket(int64(i+1), 0, actorID)); err != nil {
+				b.Fatal(err)
+			}
+		}
+		obj := crdt.NewObject(crdt.NewElementRHT(), time.InitialTicket)
+		obj.Set("text", text)
+		return obj
+	}
+	snapshot, err := converter.ObjectToBytes(build())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var heap uint64
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+
+		var obj *crdt.Object
+		if decode {
+			if obj, err = converter.BytesToObject(snapshot); err != nil {
+				b.Fatal(err)
+			}
+		} else {
+			obj = build()
+		}
+		for _, node := range obj.Get("text").(*crdt.Text).Nodes() {
+			node.ID().CreatedAt().ActorIDHex()
+		}
+
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		heap += after.HeapAlloc - before.HeapAlloc
+		runtime.KeepAlive(obj)
+	}
+
+	b.ReportMetric(float64(heap)/float64(b.N), "heap-B/op")
+}
@@ -0,0 +1,95 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func BenchmarkNodeID(b *testing.B) {
+	nodes := textNodesOfTwoActors(b, 1000)
+
+	b.Run("protobuf encoding test", func(b *testing.B) {
+		size := 0
+		for i := 0; i < b.N; i++ {
+			size = 0
+			for _, node := range nodes {
+				bytes, err := proto.Marshal(&api.TextNodeID{
+					CreatedAt: converter.ToTimeTicket(node.ID().CreatedAt()),
+					Offset:    int32(node.ID().Offset()),
+				})
+				assert.NoError(b, err)
+				size += len(bytes)
+			}
+		}
+		b.ReportMetric(float64(size), "bytes/snapshot")
+	})
+
+	b.Run("compact encoding test", func(b *testing.B) {
+		size := 0
+		for i := 0; i < b.N; i++ {
+			table := time.NewActorIDTable()
+			size = 0
+			for _, node := range nodes {
+				size += len(converter.EncodeNodeID(node.ID(), table))
+			}
+			size += table.Len() * len(time.InitialActorID.Bytes())
+		}
+		b.ReportMetric(float64(size), "bytes/snapshot")
+	})
+}
+
+// textNodesOfTwoActors returns the nodes of a text edited alternately by two
+// actors with the given number of edits.
+func textNodesOfTwoActors(b *testing.B, edits int) []*crdt.RGATreeSplitNode[*crdt.TextValue] {
+	var actors []*time.ActorID
+	for _, hex := range []string{"000000000000000000000001", "000000000000000000000002"} {
+		actorID, err := time.ActorIDFromHex(hex)
+		assert.NoError(b, err)
+		actors = append(actors, actorID)
+	}
+
+	doc := document.New("d1")
+	assert.NoError(b, doc.Update(func(root *json.Object) error {
+		root.SetNewText("k1")
+		return nil
+	}))
+
+	for i := 0; i < edits; i++ {
+		doc.SetActor(actors[i%len(actors)])
+		assert.NoError(b, doc.Update(func(root *json.Object) error {
+			text := root.GetText("k1")
+			offset := text.Len() / 2
+			text.Edit(offset, offset, "Hello, ")
+			return nil
+		}))
+	}
+
+	return doc.Root().GetText("k1").Nodes()
+}
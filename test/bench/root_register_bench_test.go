@@ -0,0 +1,68 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func BenchmarkRootRegister(b *testing.B) {
+	b.Run("register one by one test", func(b *testing.B) {
+		benchmarkRootRegister(b, 100000, false)
+	})
+
+	b.Run("register in batch test", func(b *testing.B) {
+		benchmarkRootRegister(b, 100000, true)
+	})
+}
+
+// benchmarkRootRegister measures registering the elements of a snapshot
+// with the given number of elements to an empty root, one by one or in a
+// batch.
+func benchmarkRootRegister(b *testing.B, size int, batch bool) {
+	ctx := helper.TextChangeContext(helper.TestRoot())
+	obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+	for i := 0; i < size; i++ {
+		obj.Set(strconv.Itoa(i), crdt.NewPrimitive(i, ctx.IssueTimeTicket()))
+	}
+
+	var elements []crdt.Element
+	obj.Descendants(func(elem crdt.Element, parent crdt.Container) bool {
+		elements = append(elements, elem)
+		return false
+	})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		root := crdt.NewRoot(crdt.NewObject(crdt.NewElementRHT(), time.InitialTicket))
+		if batch {
+			root.RegisterElements(elements)
+			continue
+		}
+
+		for _, elem := range elements {
+			root.RegisterElement(elem)
+		}
+	}
+}
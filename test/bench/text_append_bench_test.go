@@ -0,0 +1,62 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"testing"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func BenchmarkTextAppend(b *testing.B) {
+	b.Run("append by range test", func(b *testing.B) {
+		benchmarkTextAppend(b, 100000, false)
+	})
+
+	b.Run("append with tail test", func(b *testing.B) {
+		benchmarkTextAppend(b, 100000, true)
+	})
+}
+
+// benchmarkTextAppend measures appending a line to a text that already has
+// the given number of lines, resolving the end position through the index
+// tree or with the tail cached by Append.
+func benchmarkTextAppend(b *testing.B, lines int, withTail bool) {
+	ctx := helper.TextChangeContext(helper.TestRoot())
+	text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+	for i := 0; i < lines; i++ {
+		if err := text.Append("line\n", nil, ctx.IssueTimeTicket()); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if withTail {
+			if err := text.Append("line\n", nil, ctx.IssueTimeTicket()); err != nil {
+				b.Fatal(err)
+			}
+			continue
+		}
+
+		fromPos, toPos := text.CreateRange(text.Len(), text.Len())
+		text.Edit(fromPos, toPos, nil, "line\n", nil, ctx.IssueTimeTicket())
+	}
+}
@@ -0,0 +1,56 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"testing"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func BenchmarkTextRebalance(b *testing.B) {
+	b.Run("lookup on degenerate tree test", func(b *testing.B) {
+		benchmarkTextLookup(b, 10000, false)
+	})
+
+	b.Run("lookup on rebalanced tree test", func(b *testing.B) {
+		benchmarkTextLookup(b, 10000, true)
+	})
+}
+
+// benchmarkTextLookup measures the first lookup in the middle of a text that
+// is built by the given number of appends.
+func benchmarkTextLookup(b *testing.B, appends int, rebalance bool) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		ctx := helper.TextChangeContext(helper.TestRoot())
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+		for j := 0; j < appends; j++ {
+			fromPos, toPos := text.CreateRange(text.Len(), text.Len())
+			text.Edit(fromPos, toPos, nil, "a", nil, ctx.IssueTimeTicket())
+		}
+		if rebalance {
+			text.Rebalance()
+		}
+		b.StartTimer()
+
+		text.CreateRange(appends/2, appends/2)
+	}
+}